@@ -1,6 +1,9 @@
 package accounts
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -150,16 +153,49 @@ const (
 // Get returns a collection of accounts based on the criteria defined by its
 // input query parameter.
 func Get(client newclient.Client, spaceID string, accountsQuery *AccountsQuery) (*Accounts, error) {
-	res, err := newclient.GetByQuery[AccountResource](client, template, spaceID, accountsQuery)
+	return GetWithContext(context.Background(), client, spaceID, accountsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, accountsQuery *AccountsQuery) (*Accounts, error) {
+	res, err := newclient.GetByQueryWithContext[AccountResource](ctx, client, template, spaceID, accountsQuery)
 	if err != nil {
 		return nil, err
 	}
 	return ToAccounts(res), nil
 }
 
+// Iterate returns an iterator over the accounts matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query *AccountsQuery) iter.Seq2[IAccount, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query *AccountsQuery) iter.Seq2[IAccount, error] {
+	return func(yield func(IAccount, error) bool) {
+		for res, err := range newclient.IterateByQueryWithContext[AccountResource](ctx, client, template, spaceID, query) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			account, err := ToAccount(res)
+			if !yield(account, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // Add creates a new account.
 func Add(client newclient.Client, account IAccount) (IAccount, error) {
-	res, err := newclient.Add[AccountResource](client, template, account.GetSpaceID(), account)
+	return AddWithContext(context.Background(), client, account)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, account IAccount) (IAccount, error) {
+	res, err := newclient.AddWithContext[AccountResource](ctx, client, template, account.GetSpaceID(), account)
 	if err != nil {
 		return nil, err
 	}
@@ -168,8 +204,13 @@ func Add(client newclient.Client, account IAccount) (IAccount, error) {
 
 // GetByID returns the account that matches the input ID.
 func GetByID(client newclient.Client, spaceID string, ID string) (IAccount, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (IAccount, error) {
 
-	res, err := newclient.GetByID[AccountResource](client, template, spaceID, ID)
+	res, err := newclient.GetByIDWithContext[AccountResource](ctx, client, template, spaceID, ID)
 	if err != nil {
 		return nil, err
 	}
@@ -179,12 +220,17 @@ func GetByID(client newclient.Client, spaceID string, ID string) (IAccount, erro
 
 // Update modifies an account based on the one provided as input.
 func Update(client newclient.Client, account IAccount) (IAccount, error) {
+	return UpdateWithContext(context.Background(), client, account)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, account IAccount) (IAccount, error) {
 	accountResource, err := ToAccountResource(account)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := newclient.Update[AccountResource](client, template, account.GetSpaceID(), accountResource.ID, accountResource)
+	res, err := newclient.UpdateWithContext[AccountResource](ctx, client, template, account.GetSpaceID(), accountResource.ID, accountResource)
 	if err != nil {
 		return nil, err
 	}
@@ -194,11 +240,21 @@ func Update(client newclient.Client, account IAccount) (IAccount, error) {
 
 // DeleteByID will delete a account with the provided id.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
-	return newclient.DeleteByID(client, template, spaceID, id)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, id)
 }
 
 // GetAll returns all accounts. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]IAccount, error) {
-	items, err := newclient.GetAll[AccountResource](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]IAccount, error) {
+	items, err := newclient.GetAllWithContext[AccountResource](ctx, client, template, spaceID)
 	return ToAccountArray(items), err
 }
@@ -0,0 +1,100 @@
+package artifacts
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContent(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/artifacts/Artifacts-1/content", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<testsuite/>"))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/artifacts/Artifacts-2/content", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"The resource 'Artifacts-2' was not found."}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	var content strings.Builder
+	require.NoError(t, GetContent(client, "Spaces-1", "Artifacts-1", &content))
+	require.Equal(t, "<testsuite/>", content.String())
+
+	err := GetContent(client, "Spaces-1", "Artifacts-2", &content)
+	require.ErrorIs(t, err, core.ErrNotFound)
+	require.ErrorContains(t, err, "was not found")
+
+	require.Error(t, GetContent(client, "Spaces-1", "", &content))
+}
+
+func TestAddWithContent(t *testing.T) {
+	var uploaded string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		artifact := new(Artifact)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(artifact))
+		require.Equal(t, "ServerTasks-7", artifact.ServerTaskID)
+		artifact.ID = "Artifacts-3"
+		artifact.SpaceID = "Spaces-1"
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(artifact)
+	})
+	mux.HandleFunc("PUT /api/Spaces-1/artifacts/Artifacts-3/content", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		uploaded = string(body)
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	artifact := NewArtifact("report.xml")
+	_, err := AddWithContent(client, artifact, strings.NewReader("<testsuite/>"))
+	require.Error(t, err)
+
+	artifact.ServerTaskID = "ServerTasks-7"
+	created, err := AddWithContent(client, artifact, strings.NewReader("<testsuite/>"))
+	require.NoError(t, err)
+	require.Equal(t, "Artifacts-3", created.GetID())
+	require.Equal(t, "<testsuite/>", uploaded)
+}
+
+func TestDownloadTaskArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ServerTasks-7", r.URL.Query().Get("regarding"))
+		_, _ = w.Write([]byte(`{"Items":[
+			{"Id":"Artifacts-1","Filename":"report.xml","ServerTaskId":"ServerTasks-7"},
+			{"Id":"Artifacts-2","Filename":"report.xml","ServerTaskId":"ServerTasks-7"},
+			{"Id":"Artifacts-3","Filename":"..\\..\\escape.txt","ServerTaskId":"ServerTasks-7"},
+			{"Id":"Artifacts-4","Filename":"other.txt","ServerTaskId":"ServerTasks-8"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/artifacts/{id}/content", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("content of " + r.PathValue("id")))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	dir := filepath.Join(t.TempDir(), "artifacts")
+	paths, err := DownloadTaskArtifacts(client, "Spaces-1", "ServerTasks-7", dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "report.xml"),
+		filepath.Join(dir, "report-Artifacts-2.xml"),
+		filepath.Join(dir, "escape.txt"),
+	}, paths)
+
+	for i, path := range paths {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "content of Artifacts-"+string(rune('1'+i)), string(content))
+	}
+}
@@ -1,8 +1,16 @@
 package artifacts
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/http"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
@@ -26,6 +34,8 @@ func NewArtifactService(sling *sling.Sling, uriTemplate string) *ArtifactService
 }
 
 // Add creates a new artifact.
+//
+// Deprecated: use artifacts.Add
 func (s *ArtifactService) Add(artifact *Artifact) (*Artifact, error) {
 	if IsNil(artifact) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterArtifact)
@@ -47,6 +57,8 @@ func (s *ArtifactService) Add(artifact *Artifact) (*Artifact, error) {
 // Get returns a collection of artifacts based on the criteria defined by its
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
+//
+// Deprecated: use artifacts.Get
 func (s *ArtifactService) Get(artifactsQuery Query) (*resources.Resources[*Artifact], error) {
 	v, _ := query.Values(artifactsQuery)
 	path := s.BasePath
@@ -76,6 +88,8 @@ func (s *ArtifactService) GetAll() ([]*Artifact, error) {
 
 // GetByID returns the artifact that matches the input ID. If one cannot be
 // found, it returns nil and an error.
+//
+// Deprecated: use artifacts.GetByID
 func (s *ArtifactService) GetByID(id string) (*Artifact, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
@@ -108,3 +122,177 @@ func (s *ArtifactService) Update(artifact Artifact) (*Artifact, error) {
 
 	return resp.(*Artifact), nil
 }
+
+// --- new ---
+
+const (
+	template        = "/api/{spaceId}/artifacts{/id}{?skip,take,regarding,ids,partialName,order}"
+	contentTemplate = "/api/{spaceId}/artifacts/{id}/content"
+)
+
+// Get returns a collection of artifacts based on the criteria defined by its
+// input query parameter. If an error occurs, an empty collection is returned
+// along with the associated error.
+func Get(client newclient.Client, spaceID string, artifactsQuery Query) (*resources.Resources[*Artifact], error) {
+	return GetWithContext(context.Background(), client, spaceID, artifactsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, artifactsQuery Query) (*resources.Resources[*Artifact], error) {
+	return newclient.GetByQueryWithContext[Artifact](ctx, client, template, spaceID, artifactsQuery)
+}
+
+// Iterate returns an iterator over the artifacts matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query Query) iter.Seq2[*Artifact, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query Query) iter.Seq2[*Artifact, error] {
+	return newclient.IterateByQueryWithContext[Artifact](ctx, client, template, spaceID, query)
+}
+
+// Add creates a new artifact. The file content of the artifact is uploaded
+// separately with UploadContent.
+func Add(client newclient.Client, artifact *Artifact) (*Artifact, error) {
+	return AddWithContext(context.Background(), client, artifact)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, artifact *Artifact) (*Artifact, error) {
+	if IsNil(artifact) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterArtifact)
+	}
+	return newclient.AddWithContext[Artifact](ctx, client, template, artifact.SpaceID, artifact)
+}
+
+// GetByID returns the artifact that matches the input ID. If one cannot be
+// found, it returns nil and an error.
+func GetByID(client newclient.Client, spaceID string, ID string) (*Artifact, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Artifact, error) {
+	return newclient.GetByIDWithContext[Artifact](ctx, client, template, spaceID, ID)
+}
+
+// GetContent streams the file content of the artifact that matches the input
+// ID to w.
+func GetContent(client newclient.Client, spaceID string, ID string, w io.Writer) error {
+	return GetContentWithContext(context.Background(), client, spaceID, ID, w)
+}
+
+// GetContentWithContext is like GetContent, but uses ctx to control cancellation of the HTTP requests.
+func GetContentWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, w io.Writer) error {
+	if w == nil {
+		return internal.CreateRequiredParameterIsEmptyOrNilError("w")
+	}
+	path, err := contentPath(client, spaceID, ID)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.HttpSession().DoRawRequest(req)
+	if err != nil {
+		return err
+	}
+	defer newclient.CloseResponse(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// UploadContent uploads the file content of the artifact that matches the
+// input ID, replacing any existing content.
+func UploadContent(client newclient.Client, spaceID string, ID string, r io.Reader) error {
+	return UploadContentWithContext(context.Background(), client, spaceID, ID, r)
+}
+
+// UploadContentWithContext is like UploadContent, but uses ctx to control cancellation of the HTTP requests.
+func UploadContentWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, r io.Reader) error {
+	if r == nil {
+		return internal.CreateRequiredParameterIsEmptyOrNilError("r")
+	}
+	path, err := contentPath(client, spaceID, ID)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, path, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := client.HttpSession().DoRawRequest(req)
+	if err != nil {
+		return err
+	}
+	defer newclient.CloseResponse(resp)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newAPIError(resp)
+	}
+	return nil
+}
+
+// AddWithContent creates a new artifact attached to the server task set in
+// its ServerTaskID, and uploads its file content from r.
+func AddWithContent(client newclient.Client, artifact *Artifact, r io.Reader) (*Artifact, error) {
+	return AddWithContentWithContext(context.Background(), client, artifact, r)
+}
+
+// AddWithContentWithContext is like AddWithContent, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContentWithContext(ctx context.Context, client newclient.Client, artifact *Artifact, r io.Reader) (*Artifact, error) {
+	if IsNil(artifact) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterArtifact)
+	}
+	if internal.IsEmpty(artifact.ServerTaskID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("ServerTaskID")
+	}
+	if r == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("r")
+	}
+
+	created, err := AddWithContext(ctx, client, artifact)
+	if err != nil {
+		return nil, err
+	}
+	if err := UploadContentWithContext(ctx, client, created.SpaceID, created.GetID(), r); err != nil {
+		return created, err
+	}
+	return created, nil
+}
+
+func contentPath(client newclient.Client, spaceID string, ID string) (string, error) {
+	if client == nil {
+		return "", internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if internal.IsEmpty(ID) {
+		return "", internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return "", err
+	}
+	return client.URITemplateCache().Expand(contentTemplate, map[string]any{
+		"spaceId": spaceID,
+		"id":      ID,
+	})
+}
+
+// newAPIError creates an APIError from a response carrying an error payload.
+func newAPIError(resp *http.Response) error {
+	apiError := new(core.APIError)
+	_ = json.NewDecoder(resp.Body).Decode(apiError)
+	return core.NewAPIError(resp, apiError)
+}
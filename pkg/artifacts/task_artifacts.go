@@ -0,0 +1,87 @@
+package artifacts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// DownloadTaskArtifacts downloads the file content of every artifact attached
+// to the server task that matches the input ID, such as the task of a
+// deployment, into dir, and returns the paths of the files it wrote. The
+// directory is created if it does not exist.
+//
+// Each file is named after its artifact's Filename. When several artifacts
+// share a name, as when the same step publishes a file on several deployment
+// targets, the artifact ID is added to the names after the first.
+func DownloadTaskArtifacts(client newclient.Client, spaceID string, taskID string, dir string) ([]string, error) {
+	return DownloadTaskArtifactsWithContext(context.Background(), client, spaceID, taskID, dir)
+}
+
+// DownloadTaskArtifactsWithContext is like DownloadTaskArtifacts, but uses ctx to control cancellation of the HTTP requests.
+func DownloadTaskArtifactsWithContext(ctx context.Context, client newclient.Client, spaceID string, taskID string, dir string) ([]string, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if internal.IsEmpty(taskID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("taskID")
+	}
+	if internal.IsEmpty(dir) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("dir")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	used := map[string]bool{}
+	for artifact, err := range IterateWithContext(ctx, client, spaceID, Query{Regarding: taskID, Order: "asc"}) {
+		if err != nil {
+			return paths, err
+		}
+		if artifact.ServerTaskID != "" && artifact.ServerTaskID != taskID {
+			continue
+		}
+
+		name := artifactFileName(artifact, used)
+		path := filepath.Join(dir, name)
+		if err := downloadToFile(ctx, client, spaceID, artifact.GetID(), path); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// artifactFileName returns a name for an artifact's file which is safe to use
+// within a directory and has not already been used.
+func artifactFileName(artifact *Artifact, used map[string]bool) string {
+	// the name comes from the server, so it must not escape the directory
+	name := filepath.Base(filepath.Clean("/" + strings.ReplaceAll(artifact.Filename, "\\", "/")))
+	if name == "/" || name == "." {
+		name = artifact.GetID()
+	}
+	if used[strings.ToLower(name)] {
+		extension := filepath.Ext(name)
+		name = strings.TrimSuffix(name, extension) + "-" + artifact.GetID() + extension
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
+
+func downloadToFile(ctx context.Context, client newclient.Client, spaceID string, ID string, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := GetContentWithContext(ctx, client, spaceID, ID, file); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
@@ -1,8 +1,17 @@
 package buildinformation
 
 import (
+	"context"
+	"iter"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 
 	"github.com/dghubble/sling"
 )
@@ -21,3 +30,196 @@ func NewBuildInformationService(sling *sling.Sling, uriTemplate string, bulkPath
 		},
 	}
 }
+
+// BulkDelete deletes the build information that matches the input IDs.
+//
+// Deprecated: use buildinformation.BulkDelete
+func (s *BuildInformationService) BulkDelete(ids []string) error {
+	if len(ids) == 0 {
+		return internal.CreateInvalidParameterError("BulkDelete", "ids")
+	}
+
+	template, err := uritemplates.Parse(s.bulkPath)
+	if err != nil {
+		return err
+	}
+
+	path, err := template.Expand(BuildInformationBulkQuery{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	return services.ApiDelete(s.GetClient(), path)
+}
+
+// Get returns a collection of build information based on the criteria
+// defined by its input query parameter. If an error occurs, an empty
+// collection is returned along with the associated error.
+//
+// Deprecated: use buildinformation.Get
+func (s *BuildInformationService) Get(buildInformationQuery BuildInformationQuery) (*resources.Resources[*BuildInformation], error) {
+	path, err := s.GetURITemplate().Expand(buildInformationQuery)
+	if err != nil {
+		return &resources.Resources[*BuildInformation]{}, err
+	}
+
+	response, err := api.ApiGet(s.GetClient(), new(resources.Resources[*BuildInformation]), path)
+	if err != nil {
+		return &resources.Resources[*BuildInformation]{}, err
+	}
+
+	return response.(*resources.Resources[*BuildInformation]), nil
+}
+
+// GetByID returns the build information that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+//
+// Deprecated: use buildinformation.GetByID
+func (s *BuildInformationService) GetByID(id string) (*BuildInformation, error) {
+	if internal.IsEmpty(id) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
+	}
+
+	path, err := services.GetByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(BuildInformation), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*BuildInformation), nil
+}
+
+// Push creates the build information of a version of a package. The
+// overwrite mode instructs the server what to do if the version already has
+// build information.
+//
+// Deprecated: use buildinformation.Push
+func (s *BuildInformationService) Push(command *CreateBuildInformationCommand, overwriteMode core.OverwriteMode) (*BuildInformation, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("Push", "command")
+	}
+
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("Push", err)
+	}
+
+	path, err := s.GetURITemplate().Expand(BuildInformationQuery{OverwriteMode: string(overwriteMode)})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), command, new(BuildInformation), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*BuildInformation), nil
+}
+
+// --- new ---
+
+// Push creates the build information of a version of a package. The
+// overwrite mode instructs the server what to do if the version already has
+// build information.
+func Push(client newclient.Client, spaceID string, command *CreateBuildInformationCommand, overwriteMode core.OverwriteMode) (*BuildInformation, error) {
+	return PushWithContext(context.Background(), client, spaceID, command, overwriteMode)
+}
+
+// PushWithContext is like Push, but uses ctx to control cancellation of the HTTP requests.
+func PushWithContext(ctx context.Context, client newclient.Client, spaceID string, command *CreateBuildInformationCommand, overwriteMode core.OverwriteMode) (*BuildInformation, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("Push", "command")
+	}
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("Push", err)
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]any{"spaceId": spaceID}
+	if overwriteMode != "" {
+		params["overwriteMode"] = overwriteMode
+	}
+	path, err := client.URITemplateCache().Expand(uritemplates.BuildInformation, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.PostWithContext[BuildInformation](ctx, client.HttpSession(), path, command)
+}
+
+// Get returns a collection of build information based on the criteria
+// defined by its input query parameter.
+func Get(client newclient.Client, spaceID string, buildInformationQuery BuildInformationQuery) (*resources.Resources[*BuildInformation], error) {
+	return GetWithContext(context.Background(), client, spaceID, buildInformationQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, buildInformationQuery BuildInformationQuery) (*resources.Resources[*BuildInformation], error) {
+	return newclient.GetByQueryWithContext[BuildInformation](ctx, client, uritemplates.BuildInformation, spaceID, buildInformationQuery)
+}
+
+// Iterate returns an iterator over the build information matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query BuildInformationQuery) iter.Seq2[*BuildInformation, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query BuildInformationQuery) iter.Seq2[*BuildInformation, error] {
+	return newclient.IterateByQueryWithContext[BuildInformation](ctx, client, uritemplates.BuildInformation, spaceID, query)
+}
+
+// GetByID returns the build information that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func GetByID(client newclient.Client, spaceID string, ID string) (*BuildInformation, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*BuildInformation, error) {
+	return newclient.GetByIDWithContext[BuildInformation](ctx, client, uritemplates.BuildInformation, spaceID, ID)
+}
+
+// DeleteByID deletes the build information that matches the input ID.
+func DeleteByID(client newclient.Client, spaceID string, ID string) error {
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, uritemplates.BuildInformation, spaceID, ID)
+}
+
+// BulkDelete deletes the build information that matches the input IDs.
+func BulkDelete(client newclient.Client, spaceID string, IDs []string) error {
+	return BulkDeleteWithContext(context.Background(), client, spaceID, IDs)
+}
+
+// BulkDeleteWithContext is like BulkDelete, but uses ctx to control cancellation of the HTTP requests.
+func BulkDeleteWithContext(ctx context.Context, client newclient.Client, spaceID string, IDs []string) error {
+	if len(IDs) == 0 {
+		return internal.CreateInvalidParameterError("BulkDelete", "IDs")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return err
+	}
+
+	path, err := client.URITemplateCache().Expand(uritemplates.BuildInformationBulk, map[string]any{
+		"spaceId": spaceID,
+		"ids":     IDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), path)
+}
@@ -1,11 +1,18 @@
 package buildinformation
 
 import (
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/issuetrackers"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
 	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBuildInformationService(t *testing.T) {
@@ -33,3 +40,67 @@ func TestNewBuildInformationService(t *testing.T) {
 		})
 	}
 }
+
+func TestPush(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/build-information", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "OverwriteExisting", r.URL.Query().Get("overwriteMode"))
+
+		command := new(CreateBuildInformationCommand)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(command))
+		require.Equal(t, "MyApp", command.PackageID)
+		require.Equal(t, "main", command.OctopusBuildInformation.Branch)
+		require.Equal(t, "abc123", command.OctopusBuildInformation.Commits[0].ID)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"Id":"BuildInformation-1","PackageId":"MyApp","Version":"1.0.0","Branch":"main","Commits":[{"Id":"abc123","Comment":"Fix #12","LinkUrl":"https://example.com/abc123"}],"WorkItems":[{"Id":"12","Source":"GitHub"}]}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	_, err := Push(client, "Spaces-1", NewCreateBuildInformationCommand("MyApp", "1.0.0", nil), core.OverwriteModeOverwriteExisting)
+	require.Error(t, err)
+
+	command := NewCreateBuildInformationCommand("MyApp", "1.0.0", &OctopusBuildInformation{
+		Branch:  "main",
+		Commits: []*issuetrackers.Commit{{ID: "abc123", Comment: "Fix #12"}},
+	})
+	buildInformation, err := Push(client, "Spaces-1", command, core.OverwriteModeOverwriteExisting)
+	require.NoError(t, err)
+	require.Equal(t, "BuildInformation-1", buildInformation.GetID())
+	require.Equal(t, "https://example.com/abc123", buildInformation.Commits[0].LinkURL)
+	require.Equal(t, "12", buildInformation.WorkItems[0].ID)
+}
+
+func TestGetAndDelete(t *testing.T) {
+	var deleted []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/build-information", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "MyApp", r.URL.Query().Get("packageId"))
+		_, _ = w.Write([]byte(`{"Items":[{"Id":"BuildInformation-1","PackageId":"MyApp","Version":"1.0.0"}],"TotalResults":1}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/build-information/BuildInformation-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":"BuildInformation-1","PackageId":"MyApp","Version":"1.0.0"}`))
+	})
+	mux.HandleFunc("DELETE /api/Spaces-1/build-information/BuildInformation-1", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, "BuildInformation-1")
+	})
+	mux.HandleFunc("DELETE /api/Spaces-1/build-information/bulk", func(w http.ResponseWriter, r *http.Request) {
+		// the server accepts the IDs as a comma-separated list
+		deleted = append(deleted, strings.Split(r.URL.Query().Get("ids"), ",")...)
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	results, err := Get(client, "Spaces-1", BuildInformationQuery{PackageID: "MyApp"})
+	require.NoError(t, err)
+	require.Len(t, results.Items, 1)
+
+	buildInformation, err := GetByID(client, "Spaces-1", "BuildInformation-1")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", buildInformation.Version)
+
+	require.NoError(t, DeleteByID(client, "Spaces-1", "BuildInformation-1"))
+	require.NoError(t, BulkDelete(client, "Spaces-1", []string{"BuildInformation-2", "BuildInformation-3"}))
+	require.Equal(t, []string{"BuildInformation-1", "BuildInformation-2", "BuildInformation-3"}, deleted)
+
+	require.Error(t, BulkDelete(client, "Spaces-1", nil))
+}
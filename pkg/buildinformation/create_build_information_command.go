@@ -0,0 +1,42 @@
+package buildinformation
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/issuetrackers"
+	"github.com/go-playground/validator/v10"
+)
+
+// CreateBuildInformationCommand pushes the build information of a version of
+// a package to the server, which links it to any work items mentioned in the
+// commit messages.
+type CreateBuildInformationCommand struct {
+	OctopusBuildInformation *OctopusBuildInformation `json:"OctopusBuildInformation" validate:"required"`
+	PackageID               string                   `json:"PackageId" validate:"required"`
+	Version                 string                   `json:"Version" validate:"required"`
+}
+
+// OctopusBuildInformation describes the build which produced a package.
+type OctopusBuildInformation struct {
+	Branch           string                  `json:"Branch,omitempty"`
+	BuildEnvironment string                  `json:"BuildEnvironment,omitempty"`
+	BuildNumber      string                  `json:"BuildNumber,omitempty"`
+	BuildURL         string                  `json:"BuildUrl,omitempty"`
+	Commits          []*issuetrackers.Commit `json:"Commits,omitempty"`
+	VcsCommitNumber  string                  `json:"VcsCommitNumber,omitempty"`
+	VcsRoot          string                  `json:"VcsRoot,omitempty"`
+	VcsType          string                  `json:"VcsType,omitempty"`
+}
+
+// NewCreateBuildInformationCommand creates and initializes a command to push
+// the build information of the input version of a package.
+func NewCreateBuildInformationCommand(packageID string, version string, buildInformation *OctopusBuildInformation) *CreateBuildInformationCommand {
+	return &CreateBuildInformationCommand{
+		OctopusBuildInformation: buildInformation,
+		PackageID:               packageID,
+		Version:                 version,
+	}
+}
+
+// Validate checks the state of the command and returns an error if invalid.
+func (c *CreateBuildInformationCommand) Validate() error {
+	return validator.New().Struct(c)
+}
@@ -1,7 +1,9 @@
 package certificates
 
 import (
+	"context"
 	"fmt"
+	"iter"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
@@ -181,31 +183,73 @@ const template = "/api/{spaceId}/certificates{/id}{?skip,take,search,archived,te
 // query parameter. If an error occurs, a nil is returned along
 // with the associated error.
 func Get(client newclient.Client, spaceID string, certificatesQuery CertificatesQuery) (*resources.Resources[*CertificateResource], error) {
-	return newclient.GetByQuery[CertificateResource](client, template, spaceID, certificatesQuery)
+	return GetWithContext(context.Background(), client, spaceID, certificatesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, certificatesQuery CertificatesQuery) (*resources.Resources[*CertificateResource], error) {
+	return newclient.GetByQueryWithContext[CertificateResource](ctx, client, template, spaceID, certificatesQuery)
+}
+
+// Iterate returns an iterator over the certificates matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query CertificatesQuery) iter.Seq2[*CertificateResource, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query CertificatesQuery) iter.Seq2[*CertificateResource, error] {
+	return newclient.IterateByQueryWithContext[CertificateResource](ctx, client, template, spaceID, query)
 }
 
 // Add creates a new certificate.
 func Add(client newclient.Client, certificate *CertificateResource) (*CertificateResource, error) {
-	return newclient.Add[CertificateResource](client, template, certificate.SpaceID, certificate)
+	return AddWithContext(context.Background(), client, certificate)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, certificate *CertificateResource) (*CertificateResource, error) {
+	return newclient.AddWithContext[CertificateResource](ctx, client, template, certificate.SpaceID, certificate)
 }
 
 // DeleteByID deletes a certificate based on the provided ID.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetByID returns the certificate that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*CertificateResource, error) {
-	return newclient.GetByID[CertificateResource](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*CertificateResource, error) {
+	return newclient.GetByIDWithContext[CertificateResource](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a Certificate based on the one provided as input.
 func Update(client newclient.Client, resource *CertificateResource) (*CertificateResource, error) {
-	return newclient.Update[CertificateResource](client, template, resource.SpaceID, resource.ID, resource)
+	return UpdateWithContext(context.Background(), client, resource)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, resource *CertificateResource) (*CertificateResource, error) {
+	return newclient.UpdateWithContext[CertificateResource](ctx, client, template, resource.SpaceID, resource.ID, resource)
 }
 
 // GetAll returns all certificates. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*CertificateResource, error) {
-	return newclient.GetAll[CertificateResource](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*CertificateResource, error) {
+	return newclient.GetAllWithContext[CertificateResource](ctx, client, template, spaceID)
 }
@@ -1,12 +1,16 @@
 package channels
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 )
 
@@ -119,38 +123,145 @@ func (s *ChannelService) Update(channel *Channel) (*Channel, error) {
 	return resp.(*Channel), nil
 }
 
+// TestVersionRule asks the server whether a version satisfies the version
+// range and pre-release tag of a channel rule.
+//
+// Deprecated: use channels.TestVersionRule
+func (s *ChannelService) TestVersionRule(query VersionRuleTestQuery) (*VersionRuleTestResult, error) {
+	if internal.IsEmpty(s.versionRuleTestPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	template, err := uritemplates.Parse(s.versionRuleTestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := template.Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(VersionRuleTestResult), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*VersionRuleTestResult), nil
+}
+
 // --- new ---
 
 const template = "/api/{spaceId}/channels{/id}{?skip,take,ids,partialName}"
 
 // Add creates a new channel.
 func Add(client newclient.Client, channel *Channel) (*Channel, error) {
-	return newclient.Add[Channel](client, template, channel.SpaceID, channel)
+	return AddWithContext(context.Background(), client, channel)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, channel *Channel) (*Channel, error) {
+	return newclient.AddWithContext[Channel](ctx, client, template, channel.SpaceID, channel)
 }
 
 // Get returns a collection of channels based on the criteria defined by its
 // input query parameter.
 func Get(client newclient.Client, spaceID string, channelsQuery Query) (*resources.Resources[*Channel], error) {
-	return newclient.GetByQuery[Channel](client, template, spaceID, channelsQuery)
+	return GetWithContext(context.Background(), client, spaceID, channelsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, channelsQuery Query) (*resources.Resources[*Channel], error) {
+	return newclient.GetByQueryWithContext[Channel](ctx, client, template, spaceID, channelsQuery)
+}
+
+// Iterate returns an iterator over the channels matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query Query) iter.Seq2[*Channel, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query Query) iter.Seq2[*Channel, error] {
+	return newclient.IterateByQueryWithContext[Channel](ctx, client, template, spaceID, query)
 }
 
 // GetByID returns the channel that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Channel, error) {
-	return newclient.GetByID[Channel](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Channel, error) {
+	return newclient.GetByIDWithContext[Channel](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a channel based on the one provided as input.
 func Update(client newclient.Client, channel *Channel) (*Channel, error) {
-	return newclient.Update[Channel](client, template, channel.SpaceID, channel.ID, channel)
+	return UpdateWithContext(context.Background(), client, channel)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, channel *Channel) (*Channel, error) {
+	return newclient.UpdateWithContext[Channel](ctx, client, template, channel.SpaceID, channel.ID, channel)
 }
 
 // DeleteById deletes the channel based on the ID provided as input.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
+}
+
+// TestVersionRule asks the server whether a version satisfies the version
+// range and pre-release tag of a channel rule. EvaluateVersionRule answers
+// the same question without contacting the server.
+func TestVersionRule(client newclient.Client, spaceID string, query VersionRuleTestQuery) (*VersionRuleTestResult, error) {
+	return TestVersionRuleWithContext(context.Background(), client, spaceID, query)
+}
+
+// TestVersionRuleWithContext is like TestVersionRule, but uses ctx to control cancellation of the HTTP requests.
+func TestVersionRuleWithContext(ctx context.Context, client newclient.Client, spaceID string, query VersionRuleTestQuery) (*VersionRuleTestResult, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]any{"spaceId": spaceID}
+	if query.Version != "" {
+		params["version"] = query.Version
+	}
+	if query.VersionRange != "" {
+		params["versionRange"] = query.VersionRange
+	}
+	if query.PreReleaseTag != "" {
+		params["preReleaseTag"] = query.PreReleaseTag
+	}
+	if query.FeedType != "" {
+		params["feedType"] = query.FeedType
+	}
+	path, err := client.URITemplateCache().Expand(uritemplates.ChannelVersionRuleTest, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[VersionRuleTestResult](ctx, client.HttpSession(), path)
 }
 
 // GetAll returns all channels. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*Channel, error) {
-	return newclient.GetAll[Channel](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*Channel, error) {
+	return newclient.GetAllWithContext[Channel](ctx, client, template, spaceID)
 }
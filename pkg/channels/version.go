@@ -0,0 +1,316 @@
+package channels
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VersionFormat identifies the rules used to parse and order the versions of
+// packages in a feed, and the syntax of the version ranges used with them.
+type VersionFormat string
+
+const (
+	// VersionFormatNuGet versions have up to four numeric parts and an
+	// optional pre-release label compared without regard to case. Ranges use
+	// the NuGet syntax, such as "[1.0,2.0)".
+	VersionFormatNuGet = VersionFormat("NuGet")
+
+	// VersionFormatMaven versions are ordered as Maven orders them, with
+	// qualifiers such as "alpha", "rc" and "SNAPSHOT" ranked before releases.
+	// Ranges use the Maven syntax, which allows several ranges separated by
+	// commas, and treats a bare version as matching any version.
+	VersionFormatMaven = VersionFormat("Maven")
+
+	// VersionFormatSemVer versions follow semantic versioning 2.0, with
+	// pre-release labels compared with regard to case. Ranges use the NuGet
+	// syntax.
+	VersionFormatSemVer = VersionFormat("SemVer")
+)
+
+// VersionFormatForFeedType returns the version format used by packages in
+// feeds of the given type, such as "NuGet", "Maven" or "Docker".
+func VersionFormatForFeedType(feedType string) VersionFormat {
+	switch feedType {
+	case "Maven":
+		return VersionFormatMaven
+	case "", "BuiltIn", "NuGet", "OctopusProject":
+		return VersionFormatNuGet
+	}
+	return VersionFormatSemVer
+}
+
+// version is a version parsed in one of the supported formats. Versions are
+// only compared with others of the same format.
+type version interface {
+	compare(other version) int
+
+	// preReleaseTag returns the part of the version tested against the
+	// pre-release tag of a channel rule, which is empty for releases.
+	preReleaseTag() string
+}
+
+func parseVersion(format VersionFormat, value string) (version, error) {
+	switch format {
+	case VersionFormatMaven:
+		return parseMavenVersion(value)
+	case VersionFormatNuGet:
+		return parseSemanticVersion(value, false)
+	case VersionFormatSemVer:
+		return parseSemanticVersion(value, true)
+	}
+	return nil, fmt.Errorf("unsupported version format '%s'", format)
+}
+
+// CompareVersions compares two versions in the given format, returning a
+// negative number if a is lower than b, zero if they are equal and a positive
+// number if a is higher.
+func CompareVersions(format VersionFormat, a string, b string) (int, error) {
+	versionA, err := parseVersion(format, a)
+	if err != nil {
+		return 0, err
+	}
+	versionB, err := parseVersion(format, b)
+	if err != nil {
+		return 0, err
+	}
+	return versionA.compare(versionB), nil
+}
+
+var semanticVersionLabelPattern = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// semanticVersion is a NuGet or SemVer version.
+type semanticVersion struct {
+	parts  [4]uint64
+	labels []string
+
+	// caseSensitive is set for SemVer versions, whose pre-release labels are
+	// compared with regard to case.
+	caseSensitive bool
+}
+
+func parseSemanticVersion(value string, caseSensitive bool) (*semanticVersion, error) {
+	v := &semanticVersion{caseSensitive: caseSensitive}
+
+	trimmed, _, _ := strings.Cut(strings.TrimSpace(value), "+")
+	release, preRelease, hasPreRelease := strings.Cut(trimmed, "-")
+
+	parts := strings.Split(release, ".")
+	if len(parts) > len(v.parts) {
+		return nil, fmt.Errorf("'%s' is not a valid version", value)
+	}
+	for i, part := range parts {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid version", value)
+		}
+		v.parts[i] = number
+	}
+
+	if hasPreRelease {
+		v.labels = strings.Split(preRelease, ".")
+		for _, label := range v.labels {
+			if !semanticVersionLabelPattern.MatchString(label) {
+				return nil, fmt.Errorf("'%s' is not a valid version", value)
+			}
+		}
+	}
+	return v, nil
+}
+
+func (v *semanticVersion) compare(other version) int {
+	o := other.(*semanticVersion)
+	for i := range v.parts {
+		if c := cmp.Compare(v.parts[i], o.parts[i]); c != 0 {
+			return c
+		}
+	}
+
+	// a version without a pre-release label is higher than one with
+	switch {
+	case len(v.labels) == 0 && len(o.labels) == 0:
+		return 0
+	case len(v.labels) == 0:
+		return 1
+	case len(o.labels) == 0:
+		return -1
+	}
+
+	for i := 0; i < min(len(v.labels), len(o.labels)); i++ {
+		if c := v.compareLabels(v.labels[i], o.labels[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(v.labels), len(o.labels))
+}
+
+// compareLabels compares numeric labels numerically and others
+// alphabetically, with numeric labels lower than others.
+func (v *semanticVersion) compareLabels(a string, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	case v.caseSensitive:
+		return strings.Compare(a, b)
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func (v *semanticVersion) preReleaseTag() string {
+	return strings.Join(v.labels, ".")
+}
+
+// mavenQualifiers are the qualifiers Maven knows, from lowest to highest. The
+// empty qualifier is a release.
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// mavenQualifierAliases are the qualifiers Maven treats as another.
+var mavenQualifierAliases = map[string]string{
+	"cr":      "rc",
+	"ga":      "",
+	"final":   "",
+	"release": "",
+}
+
+// mavenVersionItem is a number or qualifier in a Maven version.
+type mavenVersionItem struct {
+	isNumber bool
+
+	// value is a number without leading zeros, or a lower case qualifier.
+	value string
+}
+
+// mavenVersion is a version ordered as Maven's ComparableVersion orders
+// them. Maven distinguishes items separated by dots from those separated by
+// hyphens; that distinction is not kept here, which only matters for
+// unusual versions such as "1-1" and "1.1".
+type mavenVersion struct {
+	items     []mavenVersionItem
+	qualifier string
+}
+
+func parseMavenVersion(value string) (*mavenVersion, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("'%s' is not a valid version", value)
+	}
+
+	v := &mavenVersion{}
+	if _, qualifier, found := strings.Cut(value, "-"); found {
+		v.qualifier = qualifier
+	}
+
+	// items are separated by dots and hyphens, and by changes between digits and letters
+	lower := strings.ToLower(value)
+	start := 0
+	for i := 0; i <= len(lower); i++ {
+		if i < len(lower) && lower[i] != '.' && lower[i] != '-' && (i == start || isDigit(lower[i]) == isDigit(lower[i-1])) {
+			continue
+		}
+		if i > start {
+			v.items = append(v.items, newMavenVersionItem(lower[start:i], i < len(lower) && isDigit(lower[i])))
+		}
+		if i < len(lower) && (lower[i] == '.' || lower[i] == '-') {
+			start = i + 1
+		} else {
+			start = i
+		}
+	}
+
+	// trailing zeros and release qualifiers do not change the version, so "1.0.0" and "1-ga" equal "1"
+	for len(v.items) > 0 && v.items[len(v.items)-1].isNull() {
+		v.items = v.items[:len(v.items)-1]
+	}
+	return v, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// newMavenVersionItem creates an item from a number or qualifier. Maven
+// abbreviates "alpha", "beta" and "milestone" to their first letter when a
+// number follows them directly, as in "1.0a1".
+func newMavenVersionItem(value string, followedByDigit bool) mavenVersionItem {
+	if isDigit(value[0]) {
+		value = strings.TrimLeft(value, "0")
+		return mavenVersionItem{isNumber: true, value: value}
+	}
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, ok := mavenQualifierAliases[value]; ok {
+		value = alias
+	}
+	return mavenVersionItem{value: value}
+}
+
+func (i mavenVersionItem) isNull() bool {
+	return i.value == ""
+}
+
+// compare orders two items, where a missing item is represented by the zero
+// item, which is both the number zero and the empty qualifier.
+func (i mavenVersionItem) compare(other mavenVersionItem) int {
+	switch {
+	case i.isNumber && other.isNumber:
+		return cmp.Or(cmp.Compare(len(i.value), len(other.value)), strings.Compare(i.value, other.value))
+	case i.isNumber:
+		// numbers are higher than qualifiers, and any number is at least the missing item
+		if other.isNull() && i.isNull() {
+			return 0
+		}
+		return 1
+	case other.isNumber:
+		return -other.compare(i)
+	}
+	return strings.Compare(comparableMavenQualifier(i.value), comparableMavenQualifier(other.value))
+}
+
+// comparableMavenQualifier returns a string which orders qualifiers as Maven
+// does: known qualifiers in the order of mavenQualifiers, and unknown ones
+// after them alphabetically.
+func comparableMavenQualifier(qualifier string) string {
+	for i, known := range mavenQualifiers {
+		if qualifier == known {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(mavenQualifiers)) + "-" + qualifier
+}
+
+func (v *mavenVersion) compare(other version) int {
+	o := other.(*mavenVersion)
+	for i := 0; i < max(len(v.items), len(o.items)); i++ {
+		if c := mavenItemAt(v.items, i).compare(mavenItemAt(o.items, i)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func mavenItemAt(items []mavenVersionItem, i int) mavenVersionItem {
+	if i < len(items) {
+		return items[i]
+	}
+	return mavenVersionItem{}
+}
+
+func (v *mavenVersion) preReleaseTag() string {
+	return v.qualifier
+}
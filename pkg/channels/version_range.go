@@ -0,0 +1,216 @@
+package channels
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VersionRange is a parsed version range, such as the VersionRange of a
+// channel rule.
+type VersionRange struct {
+	format VersionFormat
+
+	// restrictions are alternatives; a version in any of them is in the range
+	restrictions []versionRestriction
+}
+
+// versionRestriction is a single interval of versions. A nil bound is
+// unbounded.
+type versionRestriction struct {
+	min          version
+	minInclusive bool
+	max          version
+	maxInclusive bool
+}
+
+func (r versionRestriction) contains(v version) bool {
+	if r.min != nil {
+		if c := v.compare(r.min); c < 0 || (c == 0 && !r.minInclusive) {
+			return false
+		}
+	}
+	if r.max != nil {
+		if c := v.compare(r.max); c > 0 || (c == 0 && !r.maxInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseVersionRange parses a version range in the syntax used by format:
+// Maven's syntax for VersionFormatMaven, and NuGet's otherwise.
+func ParseVersionRange(format VersionFormat, versionRange string) (*VersionRange, error) {
+	value := strings.TrimSpace(versionRange)
+	if value == "" {
+		return nil, fmt.Errorf("the version range is empty")
+	}
+
+	var restrictions []versionRestriction
+	var err error
+	if format == VersionFormatMaven {
+		restrictions, err = parseMavenRestrictions(value)
+	} else {
+		restrictions, err = parseNuGetRestriction(format, value)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid version range: %w", versionRange, err)
+	}
+	return &VersionRange{format: format, restrictions: restrictions}, nil
+}
+
+// Format returns the version format the range was parsed with.
+func (r *VersionRange) Format() VersionFormat {
+	return r.format
+}
+
+// Contains reports whether a version is in the range. It returns an error
+// if the version cannot be parsed in the format of the range.
+func (r *VersionRange) Contains(versionString string) (bool, error) {
+	v, err := parseVersion(r.format, versionString)
+	if err != nil {
+		return false, err
+	}
+	return r.contains(v), nil
+}
+
+func (r *VersionRange) contains(v version) bool {
+	for _, restriction := range r.restrictions {
+		if restriction.contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNuGetRestriction parses a NuGet version range. A bare version is a
+// minimum, so "1.0" contains "1.0" and everything above it. As in NuGet, a
+// floating version such as "1.*" is also a minimum; the float only affects
+// which version NuGet prefers, not which versions satisfy the range.
+func parseNuGetRestriction(format VersionFormat, value string) ([]versionRestriction, error) {
+	first, last := value[0], value[len(value)-1]
+	if first != '[' && first != '(' {
+		minimum, err := parseFloatingVersion(format, value)
+		if err != nil {
+			return nil, err
+		}
+		return []versionRestriction{{min: minimum, minInclusive: true}}, nil
+	}
+	if last != ']' && last != ')' {
+		return nil, fmt.Errorf("the range is not closed")
+	}
+	restriction, err := parseRestriction(format, value)
+	if err != nil {
+		return nil, err
+	}
+	return []versionRestriction{restriction}, nil
+}
+
+// parseRestriction parses an interval in brackets, such as "[1.0,2.0)",
+// "(,1.0]" or "[1.0]". The syntax is shared by NuGet and Maven.
+func parseRestriction(format VersionFormat, value string) (versionRestriction, error) {
+	restriction := versionRestriction{minInclusive: value[0] == '[', maxInclusive: value[len(value)-1] == ']'}
+	bounds := strings.Split(value[1:len(value)-1], ",")
+	switch len(bounds) {
+	case 1:
+		// "[1.0]" is exactly 1.0; "(1.0)" contains nothing
+		if !restriction.minInclusive || !restriction.maxInclusive {
+			return restriction, fmt.Errorf("a range of a single version must be inclusive")
+		}
+		exact, err := parseVersion(format, bounds[0])
+		if err != nil {
+			return restriction, err
+		}
+		restriction.min, restriction.max = exact, exact
+	case 2:
+		var err error
+		if restriction.min, err = parseBound(format, bounds[0]); err != nil {
+			return restriction, err
+		}
+		if restriction.max, err = parseBound(format, bounds[1]); err != nil {
+			return restriction, err
+		}
+		if restriction.min == nil && restriction.max == nil && format != VersionFormatMaven {
+			return restriction, fmt.Errorf("the range has no bounds")
+		}
+	default:
+		return restriction, fmt.Errorf("the range has more than two bounds")
+	}
+	return restriction, validateRestriction(restriction)
+}
+
+// parseFloatingVersion parses a version which may end with a "*", such as
+// "1.*" or "1.0.0-*", returning the lowest version it matches.
+func parseFloatingVersion(format VersionFormat, value string) (version, error) {
+	if value == "*" {
+		return parseVersion(format, "0")
+	}
+	if prefix, found := strings.CutSuffix(value, ".*"); found {
+		return parseVersion(format, prefix)
+	}
+	if prefix, found := strings.CutSuffix(value, "-*"); found {
+		// the lowest pre-release label is "0"
+		return parseVersion(format, prefix+"-0")
+	}
+	return parseVersion(format, value)
+}
+
+func parseBound(format VersionFormat, value string) (version, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	return parseVersion(format, value)
+}
+
+func validateRestriction(restriction versionRestriction) error {
+	if restriction.min == nil || restriction.max == nil {
+		return nil
+	}
+	c := restriction.min.compare(restriction.max)
+	if c > 0 || (c == 0 && !(restriction.minInclusive && restriction.maxInclusive)) {
+		return fmt.Errorf("the lower bound of the range is above its upper bound")
+	}
+	return nil
+}
+
+// parseMavenRestrictions parses a Maven version range, which is a comma
+// separated list of ranges such as "[1.0,2.0),[3.0,)". A bare version is a
+// recommendation rather than a restriction, so it matches any version.
+func parseMavenRestrictions(value string) ([]versionRestriction, error) {
+	if value[0] != '[' && value[0] != '(' {
+		if strings.ContainsAny(value, "[]()") {
+			return nil, fmt.Errorf("a range cannot follow a version")
+		}
+		if _, err := parseMavenVersion(value); err != nil {
+			return nil, err
+		}
+		return []versionRestriction{{}}, nil
+	}
+
+	var restrictions []versionRestriction
+	for rest := value; rest != ""; {
+		end := strings.IndexAny(rest, "])")
+		if rest[0] != '[' && rest[0] != '(' || end < 0 {
+			return nil, fmt.Errorf("the range is not closed")
+		}
+		restriction, err := parseRestriction(VersionFormatMaven, rest[:end+1])
+		if err != nil {
+			return nil, err
+		}
+		if len(restrictions) > 0 {
+			previous := restrictions[len(restrictions)-1]
+			if previous.max == nil || restriction.min == nil || restriction.min.compare(previous.max) < 0 {
+				return nil, fmt.Errorf("the ranges overlap")
+			}
+		}
+		restrictions = append(restrictions, restriction)
+
+		rest = strings.TrimSpace(rest[end+1:])
+		if after, found := strings.CutPrefix(rest, ","); found {
+			rest = strings.TrimSpace(after)
+			if rest == "" {
+				return nil, fmt.Errorf("the range ends with a comma")
+			}
+		}
+	}
+	return restrictions, nil
+}
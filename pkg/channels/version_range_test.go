@@ -0,0 +1,142 @@
+package channels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionOrdering(t *testing.T) {
+	testCases := []struct {
+		format VersionFormat
+		lower  string
+		higher string
+	}{
+		{VersionFormatNuGet, "1.0", "1.0.1"},
+		{VersionFormatNuGet, "1.0.0.1", "1.0.1"},
+		{VersionFormatNuGet, "1.0.0-beta", "1.0.0"},
+		{VersionFormatNuGet, "1.0.0-alpha", "1.0.0-Beta"},
+		{VersionFormatNuGet, "1.0.0-beta.2", "1.0.0-beta.10"},
+		{VersionFormatNuGet, "1.0.0-beta", "1.0.0-beta.1"},
+		{VersionFormatNuGet, "1.0.0-1", "1.0.0-alpha"},
+		{VersionFormatSemVer, "1.0.0-Beta", "1.0.0-alpha"},
+		{VersionFormatSemVer, "1.9.0", "1.10.0"},
+		{VersionFormatMaven, "1.0-alpha", "1.0-beta"},
+		{VersionFormatMaven, "1.0-beta", "1.0-milestone"},
+		{VersionFormatMaven, "1.0-rc", "1.0-SNAPSHOT"},
+		{VersionFormatMaven, "1.0-SNAPSHOT", "1.0"},
+		{VersionFormatMaven, "1.0", "1.0-sp"},
+		{VersionFormatMaven, "1.0-sp", "1.0-foo"},
+		{VersionFormatMaven, "1.0a1", "1.0b1"},
+		{VersionFormatMaven, "1.0", "1.0.1"},
+		{VersionFormatMaven, "1.9", "1.10"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.format)+"/"+tc.lower+"<"+tc.higher, func(t *testing.T) {
+			result, err := CompareVersions(tc.format, tc.lower, tc.higher)
+			require.NoError(t, err)
+			require.Equal(t, -1, result)
+			result, err = CompareVersions(tc.format, tc.higher, tc.lower)
+			require.NoError(t, err)
+			require.Equal(t, 1, result)
+		})
+	}
+}
+
+func TestVersionEquality(t *testing.T) {
+	testCases := []struct {
+		format VersionFormat
+		a      string
+		b      string
+	}{
+		{VersionFormatNuGet, "1.0", "1.0.0.0"},
+		{VersionFormatNuGet, "1.0.0+build", "1.0.0"},
+		{VersionFormatNuGet, "1.0.0-BETA", "1.0.0-beta"},
+		{VersionFormatMaven, "1.0.0", "1"},
+		{VersionFormatMaven, "1-ga", "1"},
+		{VersionFormatMaven, "1.0-final", "1.0-release"},
+		{VersionFormatMaven, "1.0-cr1", "1.0-RC1"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.format)+"/"+tc.a+"="+tc.b, func(t *testing.T) {
+			a, err := parseVersion(tc.format, tc.a)
+			require.NoError(t, err)
+			b, err := parseVersion(tc.format, tc.b)
+			require.NoError(t, err)
+			require.Equal(t, 0, a.compare(b))
+		})
+	}
+}
+
+func TestVersionRangeContains(t *testing.T) {
+	testCases := []struct {
+		format       VersionFormat
+		versionRange string
+		contains     []string
+		excludes     []string
+	}{
+		{VersionFormatNuGet, "1.0", []string{"1.0", "1.0.1", "2.0"}, []string{"0.9", "1.0.0-beta"}},
+		{VersionFormatNuGet, "[1.0]", []string{"1.0.0"}, []string{"1.0.1"}},
+		{VersionFormatNuGet, "(1.0,)", []string{"1.0.1"}, []string{"1.0"}},
+		{VersionFormatNuGet, "[1.0,2.0)", []string{"1.0", "1.9.9", "2.0.0-beta"}, []string{"2.0", "0.9"}},
+		{VersionFormatNuGet, "(,1.0]", []string{"0.1", "1.0"}, []string{"1.0.1"}},
+		{VersionFormatNuGet, "1.*", []string{"1.0", "1.5", "3.0"}, []string{"0.9"}},
+		{VersionFormatNuGet, "*", []string{"0.0.1", "9.0"}, nil},
+		{VersionFormatSemVer, "[1.0.0, 2.0.0]", []string{"1.0.0", "2.0.0"}, []string{"2.0.1"}},
+		{VersionFormatMaven, "1.0", []string{"0.1", "1.0", "5.0"}, nil},
+		{VersionFormatMaven, "[1.0,2.0)", []string{"1.0", "1.5-SNAPSHOT"}, []string{"2.0", "0.9"}},
+		{VersionFormatMaven, "[1.0]", []string{"1.0.0"}, []string{"1.0.1"}},
+		{VersionFormatMaven, "(,1.0],[1.2,)", []string{"0.5", "1.0", "1.2", "3.0"}, []string{"1.1"}},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.format)+"/"+tc.versionRange, func(t *testing.T) {
+			versionRange, err := ParseVersionRange(tc.format, tc.versionRange)
+			require.NoError(t, err)
+			for _, v := range tc.contains {
+				contains, err := versionRange.Contains(v)
+				require.NoError(t, err)
+				require.True(t, contains, v)
+			}
+			for _, v := range tc.excludes {
+				contains, err := versionRange.Contains(v)
+				require.NoError(t, err)
+				require.False(t, contains, v)
+			}
+		})
+	}
+}
+
+func TestParseVersionRangeRejectsInvalidRanges(t *testing.T) {
+	testCases := []struct {
+		format       VersionFormat
+		versionRange string
+	}{
+		{VersionFormatNuGet, ""},
+		{VersionFormatNuGet, "[1.0"},
+		{VersionFormatNuGet, "(1.0)"},
+		{VersionFormatNuGet, "[2.0,1.0]"},
+		{VersionFormatNuGet, "[1.0,2.0,3.0]"},
+		{VersionFormatNuGet, "(,)"},
+		{VersionFormatNuGet, "latest"},
+		{VersionFormatNuGet, "[1.0,2.0),[3.0,)"},
+		{VersionFormatSemVer, "[1.0.0-beta..1,)"},
+		{VersionFormatMaven, "[1.0,2.0),"},
+		{VersionFormatMaven, "[1.0,2.0),[1.5,3.0)"},
+		{VersionFormatMaven, "[1.0,),[2.0,)"},
+		{VersionFormatMaven, "1.0,[2.0,)"},
+		{VersionFormatMaven, "[1.0,2.0"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.format)+"/"+tc.versionRange, func(t *testing.T) {
+			_, err := ParseVersionRange(tc.format, tc.versionRange)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestVersionFormatForFeedType(t *testing.T) {
+	require.Equal(t, VersionFormatNuGet, VersionFormatForFeedType(""))
+	require.Equal(t, VersionFormatNuGet, VersionFormatForFeedType("BuiltIn"))
+	require.Equal(t, VersionFormatMaven, VersionFormatForFeedType("Maven"))
+	require.Equal(t, VersionFormatSemVer, VersionFormatForFeedType("Docker"))
+}
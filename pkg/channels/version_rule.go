@@ -0,0 +1,75 @@
+package channels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// EvaluateVersionRule tests a version against a version range and
+// pre-release tag without contacting the server, answering the question
+// TestVersionRule asks of it. The version and range are interpreted in the
+// format used by query.FeedType; see VersionFormatForFeedType.
+//
+// The pre-release tag is a regular expression matched against the
+// pre-release label of the version, so "^$" accepts only releases. Go's
+// regular expressions do not support some constructs the server accepts,
+// such as lookarounds, and rules using them are reported as errors.
+func EvaluateVersionRule(query VersionRuleTestQuery) *VersionRuleTestResult {
+	if strings.TrimSpace(query.Version) == "" {
+		return &VersionRuleTestResult{IsNull: true}
+	}
+
+	result := &VersionRuleTestResult{}
+	format := VersionFormatForFeedType(query.FeedType)
+	v, err := parseVersion(format, query.Version)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+
+	if query.VersionRange == "" {
+		result.SatisfiesVersionRange = true
+	} else if versionRange, err := ParseVersionRange(format, query.VersionRange); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.SatisfiesVersionRange = versionRange.contains(v)
+	}
+
+	if query.PreReleaseTag == "" {
+		result.SatisfiesPreReleaseTag = true
+	} else if tag, err := compilePreReleaseTag(query.PreReleaseTag); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	} else {
+		result.SatisfiesPreReleaseTag = tag.MatchString(v.preReleaseTag())
+	}
+
+	return result
+}
+
+// ValidateVersionRule checks that the version range and pre-release tag of
+// the rule can be evaluated for packages in feeds of the given type, and
+// returns an error describing each that cannot.
+func (r ChannelRule) ValidateVersionRule(feedType string) error {
+	var errs []error
+	if r.VersionRange != "" {
+		if _, err := ParseVersionRange(VersionFormatForFeedType(feedType), r.VersionRange); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if r.Tag != "" {
+		if _, err := compilePreReleaseTag(r.Tag); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func compilePreReleaseTag(tag string) (*regexp.Regexp, error) {
+	compiled, err := regexp.Compile(tag)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a valid pre-release tag: %w", tag, err)
+	}
+	return compiled, nil
+}
@@ -0,0 +1,82 @@
+package channels
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateVersionRule(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		query                  VersionRuleTestQuery
+		satisfiesVersionRange  bool
+		satisfiesPreReleaseTag bool
+	}{
+		{"NoRule", VersionRuleTestQuery{Version: "1.0.0"}, true, true},
+		{"InRange", VersionRuleTestQuery{Version: "1.5.0", VersionRange: "[1.0,2.0)"}, true, true},
+		{"OutOfRange", VersionRuleTestQuery{Version: "2.0.0", VersionRange: "[1.0,2.0)"}, false, true},
+		{"ReleasesOnly", VersionRuleTestQuery{Version: "1.0.0", PreReleaseTag: "^$"}, true, true},
+		{"PreReleaseExcluded", VersionRuleTestQuery{Version: "1.0.0-beta", PreReleaseTag: "^$"}, true, false},
+		{"PreReleaseTag", VersionRuleTestQuery{Version: "1.0.0-beta.1", PreReleaseTag: "^beta"}, true, true},
+		{"MavenQualifier", VersionRuleTestQuery{Version: "1.2-SNAPSHOT", VersionRange: "[1.0,2.0)", PreReleaseTag: "SNAPSHOT", FeedType: "Maven"}, true, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := EvaluateVersionRule(tc.query)
+			require.Empty(t, result.Errors)
+			require.Equal(t, tc.satisfiesVersionRange, result.SatisfiesVersionRange)
+			require.Equal(t, tc.satisfiesPreReleaseTag, result.SatisfiesPreReleaseTag)
+			require.Equal(t, tc.satisfiesVersionRange && tc.satisfiesPreReleaseTag, result.IsSatisfied())
+		})
+	}
+}
+
+func TestEvaluateVersionRuleReportsErrors(t *testing.T) {
+	result := EvaluateVersionRule(VersionRuleTestQuery{})
+	require.True(t, result.IsNull)
+	require.False(t, result.IsSatisfied())
+
+	result = EvaluateVersionRule(VersionRuleTestQuery{Version: "not-a-version"})
+	require.Len(t, result.Errors, 1)
+	require.False(t, result.IsSatisfied())
+
+	result = EvaluateVersionRule(VersionRuleTestQuery{Version: "1.0.0", VersionRange: "[2.0,1.0]", PreReleaseTag: "(?=beta)"})
+	require.Len(t, result.Errors, 2)
+	require.False(t, result.IsSatisfied())
+}
+
+func TestChannelRuleValidateVersionRule(t *testing.T) {
+	require.NoError(t, ChannelRule{}.ValidateVersionRule("NuGet"))
+	require.NoError(t, ChannelRule{VersionRange: "[1.0,2.0),[3.0,)", Tag: "^$"}.ValidateVersionRule("Maven"))
+	require.Error(t, ChannelRule{VersionRange: "[1.0,2.0),[3.0,)"}.ValidateVersionRule("NuGet"))
+	require.Error(t, ChannelRule{Tag: "[beta"}.ValidateVersionRule("NuGet"))
+}
+
+func TestTestVersionRule(t *testing.T) {
+	var query url.Values
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/channels/rule-test", r.URL.Path)
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"SatisfiesVersionRange":true,"SatisfiesPreReleaseTag":false,"IsNull":false}`))
+	}))
+
+	result, err := TestVersionRule(client, "", VersionRuleTestQuery{
+		FeedType:      "NuGet",
+		PreReleaseTag: "^$",
+		Version:       "1.0.0-beta",
+		VersionRange:  "[1.0,2.0)",
+	})
+	require.NoError(t, err)
+	require.True(t, result.SatisfiesVersionRange)
+	require.False(t, result.SatisfiesPreReleaseTag)
+	require.False(t, result.IsSatisfied())
+
+	require.Equal(t, "1.0.0-beta", query.Get("version"))
+	require.Equal(t, "[1.0,2.0)", query.Get("versionRange"))
+	require.Equal(t, "^$", query.Get("preReleaseTag"))
+	require.Equal(t, "NuGet", query.Get("feedType"))
+}
@@ -0,0 +1,18 @@
+package channels
+
+// VersionRuleTestResult is the outcome of testing a version against the
+// version range and pre-release tag of a channel rule.
+type VersionRuleTestResult struct {
+	Errors []string `json:"Errors,omitempty"`
+
+	// IsNull is set when there was no version to test.
+	IsNull                 bool `json:"IsNull"`
+	SatisfiesPreReleaseTag bool `json:"SatisfiesPreReleaseTag"`
+	SatisfiesVersionRange  bool `json:"SatisfiesVersionRange"`
+}
+
+// IsSatisfied reports whether the version satisfies both the version range
+// and the pre-release tag of the rule.
+func (r *VersionRuleTestResult) IsSatisfied() bool {
+	return !r.IsNull && len(r.Errors) == 0 && r.SatisfiesVersionRange && r.SatisfiesPreReleaseTag
+}
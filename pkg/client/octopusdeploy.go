@@ -528,6 +528,17 @@ func (n *Client) Sling() *sling.Sling {
 	return n.sling
 }
 
+// SetRetryPolicy applies a retry policy to every request made by this client, covering both the
+// newclient functions (via HttpSession) and the legacy sling-based services. Passing nil disables retries.
+func (n *Client) SetRetryPolicy(policy *newclient.RetryPolicy) {
+	n.httpSession.RetryPolicy = policy
+	if policy == nil {
+		n.sling.Doer(n.httpSession.HttpClient)
+		return
+	}
+	n.sling.Doer(policy.Doer(n.httpSession.HttpClient))
+}
+
 func (n *Client) GetSpaceID() string {
 	return n.spaceID
 }
@@ -0,0 +1,14 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+
+// CertificateConfiguration is a certificate the server uses to identify
+// itself, such as the one Tentacles trust. These certificates are generated
+// by the server, so they can be read but not modified.
+type CertificateConfiguration struct {
+	Name               string `json:"Name,omitempty"`
+	SignatureAlgorithm string `json:"SignatureAlgorithm,omitempty"`
+	Thumbprint         string `json:"Thumbprint,omitempty"`
+
+	resources.Resource
+}
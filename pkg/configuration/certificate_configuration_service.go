@@ -1,8 +1,15 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 )
 
@@ -15,3 +22,93 @@ func NewCertificateConfigurationService(sling *sling.Sling, uriTemplate string)
 		Service: services.NewService(constants.ServiceCertificateConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns a collection of the certificates of the server based on the
+// criteria defined by its input query parameter.
+//
+// Deprecated: use configuration.GetCertificateConfigurations
+func (s *CertificateConfigurationService) Get(query CertificateConfigurationQuery) (*resources.Resources[*CertificateConfiguration], error) {
+	if err := services.ValidateInternalState(s); err != nil {
+		return nil, err
+	}
+
+	path, err := s.GetURITemplate().Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(resources.Resources[*CertificateConfiguration]), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*resources.Resources[*CertificateConfiguration]), nil
+}
+
+// GetByID returns the certificate of the server that matches the input ID.
+// If one cannot be found, it returns nil and an error.
+//
+// Deprecated: use configuration.GetCertificateConfigurationByID
+func (s *CertificateConfigurationService) GetByID(id string) (*CertificateConfiguration, error) {
+	if internal.IsEmpty(id) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
+	}
+
+	path, err := services.GetByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(CertificateConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*CertificateConfiguration), nil
+}
+
+// --- new ---
+
+const certificateConfigurationTemplate = "/api/configuration/certificates{/id}{?skip,take,ids,partialName}"
+
+// GetCertificateConfigurations returns a collection of the certificates of
+// the server based on the criteria defined by its input query parameter.
+// The certificates are generated by the server, so there is no update.
+func GetCertificateConfigurations(client newclient.Client, query CertificateConfigurationQuery) (*resources.Resources[*CertificateConfiguration], error) {
+	return GetCertificateConfigurationsWithContext(context.Background(), client, query)
+}
+
+// GetCertificateConfigurationsWithContext is like GetCertificateConfigurations, but uses ctx to control cancellation of the HTTP requests.
+func GetCertificateConfigurationsWithContext(ctx context.Context, client newclient.Client, query CertificateConfigurationQuery) (*resources.Resources[*CertificateConfiguration], error) {
+	values, _ := uritemplates.Struct2map(query)
+	if values == nil {
+		values = map[string]any{}
+	}
+
+	path, err := client.URITemplateCache().Expand(certificateConfigurationTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[resources.Resources[*CertificateConfiguration]](ctx, client.HttpSession(), path)
+}
+
+// GetCertificateConfigurationByID returns the certificate of the server that
+// matches the input ID, such as "certificate-global".
+func GetCertificateConfigurationByID(client newclient.Client, id string) (*CertificateConfiguration, error) {
+	return GetCertificateConfigurationByIDWithContext(context.Background(), client, id)
+}
+
+// GetCertificateConfigurationByIDWithContext is like GetCertificateConfigurationByID, but uses ctx to control cancellation of the HTTP requests.
+func GetCertificateConfigurationByIDWithContext(ctx context.Context, client newclient.Client, id string) (*CertificateConfiguration, error) {
+	if internal.IsEmpty(id) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
+	}
+
+	path, err := client.URITemplateCache().Expand(certificateConfigurationTemplate, map[string]any{"id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[CertificateConfiguration](ctx, client.HttpSession(), path)
+}
@@ -0,0 +1,32 @@
+package configuration
+
+import (
+	"net/http"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateConfiguration(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/configuration/certificates", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Octopus", r.URL.Query().Get("partialName"))
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "certificate-global", "Name": "Octopus Server", "Thumbprint": "ABC123"}]}`))
+	})
+	mux.HandleFunc("GET /api/configuration/certificates/certificate-global", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "certificate-global", "Name": "Octopus Server", "Thumbprint": "ABC123"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	certificates, err := GetCertificateConfigurations(client, CertificateConfigurationQuery{PartialName: "Octopus"})
+	require.NoError(t, err)
+	require.Equal(t, "ABC123", certificates.Items[0].Thumbprint)
+
+	certificate, err := GetCertificateConfigurationByID(client, "certificate-global")
+	require.NoError(t, err)
+	require.Equal(t, "Octopus Server", certificate.Name)
+
+	_, err = GetCertificateConfigurationByID(client, "")
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package configuration
 
 import (
+	"io"
+	"net/http"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -8,6 +10,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/dghubble/sling"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewConfigurationService(t *testing.T) {
@@ -76,3 +79,19 @@ func createConfigurationService(t *testing.T) *ConfigurationService {
 	services.NewServiceTests(t, service, constants.TestURIConfiguration, constants.ServiceConfigurationService)
 	return service
 }
+
+// newConfigurationHandler serves a configuration document at path, which is
+// replaced by the body of each PUT to it.
+func newConfigurationHandler(t *testing.T, path string, document string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(document))
+	})
+	mux.HandleFunc("PUT "+path, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		document = string(body)
+		_, _ = w.Write(body)
+	})
+	return mux
+}
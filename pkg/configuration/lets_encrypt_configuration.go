@@ -0,0 +1,23 @@
+package configuration
+
+import (
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// LetsEncryptConfiguration controls the Let's Encrypt certificate used to
+// serve the web portal over HTTPS.
+type LetsEncryptConfiguration struct {
+	AcceptLetsEncryptTermsOfService bool       `json:"AcceptLetsEncryptTermsOfService"`
+	CertificateExpiry               *time.Time `json:"CertificateExpiry,omitempty"`
+	CertificateThumbprint           string     `json:"CertificateThumbprint,omitempty"`
+	DNSName                         string     `json:"DnsName,omitempty"`
+	Enabled                         bool       `json:"Enabled"`
+	HTTPSPort                       int        `json:"HttpsPort,omitempty"`
+	IPAddress                       string     `json:"IPAddress,omitempty"`
+	Path                            string     `json:"Path,omitempty"`
+	RegistrationEmailAddress        string     `json:"RegistrationEmailAddress,omitempty"`
+
+	resources.Resource
+}
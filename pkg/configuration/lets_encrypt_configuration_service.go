@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
 
@@ -15,3 +20,69 @@ func NewLetsEncryptConfigurationService(sling *sling.Sling, uriTemplate string)
 		Service: services.NewService(constants.ServiceLetsEncryptConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the Let's Encrypt configuration of the server.
+//
+// Deprecated: use configuration.GetLetsEncryptConfiguration
+func (s *LetsEncryptConfigurationService) Get() (*LetsEncryptConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(LetsEncryptConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LetsEncryptConfiguration), nil
+}
+
+// Update modifies the Let's Encrypt configuration of the server.
+//
+// Deprecated: use configuration.UpdateLetsEncryptConfiguration
+func (s *LetsEncryptConfigurationService) Update(letsEncryptConfiguration *LetsEncryptConfiguration) (*LetsEncryptConfiguration, error) {
+	if letsEncryptConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), letsEncryptConfiguration, new(LetsEncryptConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*LetsEncryptConfiguration), nil
+}
+
+// --- new ---
+
+const letsEncryptConfigurationTemplate = "/api/letsencryptconfiguration"
+
+// GetLetsEncryptConfiguration returns the Let's Encrypt configuration of the server.
+func GetLetsEncryptConfiguration(client newclient.Client) (*LetsEncryptConfiguration, error) {
+	return GetLetsEncryptConfigurationWithContext(context.Background(), client)
+}
+
+// GetLetsEncryptConfigurationWithContext is like GetLetsEncryptConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetLetsEncryptConfigurationWithContext(ctx context.Context, client newclient.Client) (*LetsEncryptConfiguration, error) {
+	return newclient.GetWithContext[LetsEncryptConfiguration](ctx, client.HttpSession(), letsEncryptConfigurationTemplate)
+}
+
+// UpdateLetsEncryptConfiguration modifies the Let's Encrypt configuration of the server.
+func UpdateLetsEncryptConfiguration(client newclient.Client, letsEncryptConfiguration *LetsEncryptConfiguration) (*LetsEncryptConfiguration, error) {
+	return UpdateLetsEncryptConfigurationWithContext(context.Background(), client, letsEncryptConfiguration)
+}
+
+// UpdateLetsEncryptConfigurationWithContext is like UpdateLetsEncryptConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdateLetsEncryptConfigurationWithContext(ctx context.Context, client newclient.Client, letsEncryptConfiguration *LetsEncryptConfiguration) (*LetsEncryptConfiguration, error) {
+	if letsEncryptConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[LetsEncryptConfiguration](ctx, client.HttpSession(), letsEncryptConfigurationTemplate, letsEncryptConfiguration)
+}
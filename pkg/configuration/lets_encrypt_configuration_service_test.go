@@ -0,0 +1,37 @@
+package configuration
+
+import (
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLetsEncryptConfiguration(t *testing.T) {
+	client := testutil.NewTestClient(t, newConfigurationHandler(t, "/api/letsencryptconfiguration", `{
+		"Id": "letsencrypt", "Enabled": false, "DnsName": "octopus.example.com", "HttpsPort": 443, "CertificateThumbprint": "ABC123"
+	}`))
+
+	letsEncryptConfiguration, err := GetLetsEncryptConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "letsencrypt", letsEncryptConfiguration.GetID())
+	require.False(t, letsEncryptConfiguration.Enabled)
+	require.Equal(t, "octopus.example.com", letsEncryptConfiguration.DNSName)
+	require.Equal(t, 443, letsEncryptConfiguration.HTTPSPort)
+
+	letsEncryptConfiguration.Enabled = true
+	letsEncryptConfiguration.AcceptLetsEncryptTermsOfService = true
+	letsEncryptConfiguration.RegistrationEmailAddress = "ops@example.com"
+	_, err = UpdateLetsEncryptConfiguration(client, letsEncryptConfiguration)
+	require.NoError(t, err)
+
+	letsEncryptConfiguration, err = GetLetsEncryptConfiguration(client)
+	require.NoError(t, err)
+	require.True(t, letsEncryptConfiguration.Enabled)
+	require.True(t, letsEncryptConfiguration.AcceptLetsEncryptTermsOfService)
+	require.Equal(t, "ops@example.com", letsEncryptConfiguration.RegistrationEmailAddress)
+	require.Equal(t, "ABC123", letsEncryptConfiguration.CertificateThumbprint)
+
+	_, err = UpdateLetsEncryptConfiguration(client, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,11 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+
+// MaintenanceConfiguration controls maintenance mode. While the server is in
+// maintenance mode, only administrators can make changes or run tasks.
+type MaintenanceConfiguration struct {
+	IsInMaintenanceMode bool `json:"IsInMaintenanceMode"`
+
+	resources.Resource
+}
@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
 
@@ -15,3 +20,88 @@ func NewMaintenanceConfigurationService(sling *sling.Sling, uriTemplate string)
 		Service: services.NewService(constants.ServiceMaintenanceConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the maintenance configuration of the server.
+//
+// Deprecated: use configuration.GetMaintenanceConfiguration
+func (s *MaintenanceConfigurationService) Get() (*MaintenanceConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(MaintenanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MaintenanceConfiguration), nil
+}
+
+// Update modifies the maintenance configuration of the server.
+//
+// Deprecated: use configuration.UpdateMaintenanceConfiguration
+func (s *MaintenanceConfigurationService) Update(maintenanceConfiguration *MaintenanceConfiguration) (*MaintenanceConfiguration, error) {
+	if maintenanceConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), maintenanceConfiguration, new(MaintenanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MaintenanceConfiguration), nil
+}
+
+// --- new ---
+
+const maintenanceConfigurationTemplate = "/api/maintenanceconfiguration"
+
+// GetMaintenanceConfiguration returns the maintenance configuration of the server.
+func GetMaintenanceConfiguration(client newclient.Client) (*MaintenanceConfiguration, error) {
+	return GetMaintenanceConfigurationWithContext(context.Background(), client)
+}
+
+// GetMaintenanceConfigurationWithContext is like GetMaintenanceConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetMaintenanceConfigurationWithContext(ctx context.Context, client newclient.Client) (*MaintenanceConfiguration, error) {
+	return newclient.GetWithContext[MaintenanceConfiguration](ctx, client.HttpSession(), maintenanceConfigurationTemplate)
+}
+
+// UpdateMaintenanceConfiguration modifies the maintenance configuration of the server.
+func UpdateMaintenanceConfiguration(client newclient.Client, maintenanceConfiguration *MaintenanceConfiguration) (*MaintenanceConfiguration, error) {
+	return UpdateMaintenanceConfigurationWithContext(context.Background(), client, maintenanceConfiguration)
+}
+
+// UpdateMaintenanceConfigurationWithContext is like UpdateMaintenanceConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdateMaintenanceConfigurationWithContext(ctx context.Context, client newclient.Client, maintenanceConfiguration *MaintenanceConfiguration) (*MaintenanceConfiguration, error) {
+	if maintenanceConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[MaintenanceConfiguration](ctx, client.HttpSession(), maintenanceConfigurationTemplate, maintenanceConfiguration)
+}
+
+// SetMaintenanceMode turns maintenance mode on or off.
+func SetMaintenanceMode(client newclient.Client, isInMaintenanceMode bool) (*MaintenanceConfiguration, error) {
+	return SetMaintenanceModeWithContext(context.Background(), client, isInMaintenanceMode)
+}
+
+// SetMaintenanceModeWithContext is like SetMaintenanceMode, but uses ctx to control cancellation of the HTTP requests.
+func SetMaintenanceModeWithContext(ctx context.Context, client newclient.Client, isInMaintenanceMode bool) (*MaintenanceConfiguration, error) {
+	maintenanceConfiguration, err := GetMaintenanceConfigurationWithContext(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if maintenanceConfiguration.IsInMaintenanceMode == isInMaintenanceMode {
+		return maintenanceConfiguration, nil
+	}
+
+	maintenanceConfiguration.IsInMaintenanceMode = isInMaintenanceMode
+	return UpdateMaintenanceConfigurationWithContext(ctx, client, maintenanceConfiguration)
+}
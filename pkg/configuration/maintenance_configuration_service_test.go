@@ -0,0 +1,42 @@
+package configuration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMaintenanceMode(t *testing.T) {
+	isInMaintenanceMode := false
+	updates := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/maintenanceconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(MaintenanceConfiguration{IsInMaintenanceMode: isInMaintenanceMode}))
+	})
+	mux.HandleFunc("PUT /api/maintenanceconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		updates++
+		var maintenanceConfiguration MaintenanceConfiguration
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&maintenanceConfiguration))
+		isInMaintenanceMode = maintenanceConfiguration.IsInMaintenanceMode
+		require.NoError(t, json.NewEncoder(w).Encode(maintenanceConfiguration))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	maintenanceConfiguration, err := SetMaintenanceMode(client, true)
+	require.NoError(t, err)
+	require.True(t, maintenanceConfiguration.IsInMaintenanceMode)
+	require.True(t, isInMaintenanceMode)
+
+	// already in maintenance mode, so nothing is updated
+	_, err = SetMaintenanceMode(client, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, updates)
+
+	maintenanceConfiguration, err = SetMaintenanceMode(client, false)
+	require.NoError(t, err)
+	require.False(t, maintenanceConfiguration.IsInMaintenanceMode)
+	require.Equal(t, 2, updates)
+}
@@ -0,0 +1,13 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+
+// PerformanceConfiguration controls settings which affect the performance of
+// the web portal.
+type PerformanceConfiguration struct {
+	// DefaultDashboardRenderMode is how the dashboard is shown, such as
+	// "ProgressionOnly" or "GroupedByEnvironment".
+	DefaultDashboardRenderMode string `json:"DefaultDashboardRenderMode,omitempty"`
+
+	resources.Resource
+}
@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
 
@@ -15,3 +20,69 @@ func NewPerformanceConfigurationService(sling *sling.Sling, uriTemplate string)
 		Service: services.NewService(constants.ServicePerformanceConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the performance configuration of the server.
+//
+// Deprecated: use configuration.GetPerformanceConfiguration
+func (s *PerformanceConfigurationService) Get() (*PerformanceConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(PerformanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*PerformanceConfiguration), nil
+}
+
+// Update modifies the performance configuration of the server.
+//
+// Deprecated: use configuration.UpdatePerformanceConfiguration
+func (s *PerformanceConfigurationService) Update(performanceConfiguration *PerformanceConfiguration) (*PerformanceConfiguration, error) {
+	if performanceConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), performanceConfiguration, new(PerformanceConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*PerformanceConfiguration), nil
+}
+
+// --- new ---
+
+const performanceConfigurationTemplate = "/api/performanceconfiguration"
+
+// GetPerformanceConfiguration returns the performance configuration of the server.
+func GetPerformanceConfiguration(client newclient.Client) (*PerformanceConfiguration, error) {
+	return GetPerformanceConfigurationWithContext(context.Background(), client)
+}
+
+// GetPerformanceConfigurationWithContext is like GetPerformanceConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetPerformanceConfigurationWithContext(ctx context.Context, client newclient.Client) (*PerformanceConfiguration, error) {
+	return newclient.GetWithContext[PerformanceConfiguration](ctx, client.HttpSession(), performanceConfigurationTemplate)
+}
+
+// UpdatePerformanceConfiguration modifies the performance configuration of the server.
+func UpdatePerformanceConfiguration(client newclient.Client, performanceConfiguration *PerformanceConfiguration) (*PerformanceConfiguration, error) {
+	return UpdatePerformanceConfigurationWithContext(context.Background(), client, performanceConfiguration)
+}
+
+// UpdatePerformanceConfigurationWithContext is like UpdatePerformanceConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdatePerformanceConfigurationWithContext(ctx context.Context, client newclient.Client, performanceConfiguration *PerformanceConfiguration) (*PerformanceConfiguration, error) {
+	if performanceConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[PerformanceConfiguration](ctx, client.HttpSession(), performanceConfigurationTemplate, performanceConfiguration)
+}
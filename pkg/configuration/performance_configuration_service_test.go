@@ -0,0 +1,30 @@
+package configuration
+
+import (
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPerformanceConfiguration(t *testing.T) {
+	client := testutil.NewTestClient(t, newConfigurationHandler(t, "/api/performanceconfiguration", `{
+		"Id": "performance", "DefaultDashboardRenderMode": "ProgressionOnly"
+	}`))
+
+	performanceConfiguration, err := GetPerformanceConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "performance", performanceConfiguration.GetID())
+	require.Equal(t, "ProgressionOnly", performanceConfiguration.DefaultDashboardRenderMode)
+
+	performanceConfiguration.DefaultDashboardRenderMode = "GroupedByEnvironment"
+	_, err = UpdatePerformanceConfiguration(client, performanceConfiguration)
+	require.NoError(t, err)
+
+	performanceConfiguration, err = GetPerformanceConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "GroupedByEnvironment", performanceConfiguration.DefaultDashboardRenderMode)
+
+	_, err = UpdatePerformanceConfiguration(client, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+
+// ServerConfiguration holds the settings which apply to every node of the
+// server.
+type ServerConfiguration struct {
+	// ServerURI is the address users browse to, which is used for links in
+	// emails and other notifications.
+	ServerURI string `json:"ServerUri,omitempty"`
+
+	resources.Resource
+}
+
+// ServerConfigurationSettingsSet is a group of related settings of the
+// server, such as those of a feature or an authentication provider.
+type ServerConfigurationSettingsSet struct {
+	ConfigurationValues []*ServerConfigurationSetting `json:"ConfigurationValues"`
+	Description         string                        `json:"Description,omitempty"`
+	Name                string                        `json:"Name,omitempty"`
+}
+
+// ServerConfigurationSetting describes a setting of the server and its
+// current value. The values of sensitive settings are not returned.
+type ServerConfigurationSetting struct {
+	Description         string      `json:"Description,omitempty"`
+	IsSensitive         bool        `json:"IsSensitive"`
+	Key                 string      `json:"Key,omitempty"`
+	ShowInPortalSummary bool        `json:"ShowInPortalSummary"`
+	Value               interface{} `json:"Value,omitempty"`
+}
@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
 
@@ -18,3 +23,105 @@ func NewServerConfigurationService(sling *sling.Sling, uriTemplate string, setti
 		Service:      services.NewService(constants.ServiceServerConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the configuration of the server.
+//
+// Deprecated: use configuration.GetServerConfiguration
+func (s *ServerConfigurationService) Get() (*ServerConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(ServerConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ServerConfiguration), nil
+}
+
+// GetSettings returns the settings of the server, grouped into sets of
+// related settings.
+//
+// Deprecated: use configuration.GetServerConfigurationSettings
+func (s *ServerConfigurationService) GetSettings() ([]*ServerConfigurationSettingsSet, error) {
+	if internal.IsEmpty(s.settingsPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new([]*ServerConfigurationSettingsSet), s.settingsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return *resp.(*[]*ServerConfigurationSettingsSet), nil
+}
+
+// Update modifies the configuration of the server.
+//
+// Deprecated: use configuration.UpdateServerConfiguration
+func (s *ServerConfigurationService) Update(serverConfiguration *ServerConfiguration) (*ServerConfiguration, error) {
+	if serverConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), serverConfiguration, new(ServerConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ServerConfiguration), nil
+}
+
+// --- new ---
+
+const (
+	serverConfigurationTemplate         = "/api/serverconfiguration"
+	serverConfigurationSettingsTemplate = "/api/serverconfiguration/settings"
+)
+
+// GetServerConfiguration returns the configuration of the server.
+func GetServerConfiguration(client newclient.Client) (*ServerConfiguration, error) {
+	return GetServerConfigurationWithContext(context.Background(), client)
+}
+
+// GetServerConfigurationWithContext is like GetServerConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetServerConfigurationWithContext(ctx context.Context, client newclient.Client) (*ServerConfiguration, error) {
+	return newclient.GetWithContext[ServerConfiguration](ctx, client.HttpSession(), serverConfigurationTemplate)
+}
+
+// UpdateServerConfiguration modifies the configuration of the server.
+func UpdateServerConfiguration(client newclient.Client, serverConfiguration *ServerConfiguration) (*ServerConfiguration, error) {
+	return UpdateServerConfigurationWithContext(context.Background(), client, serverConfiguration)
+}
+
+// UpdateServerConfigurationWithContext is like UpdateServerConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdateServerConfigurationWithContext(ctx context.Context, client newclient.Client, serverConfiguration *ServerConfiguration) (*ServerConfiguration, error) {
+	if serverConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[ServerConfiguration](ctx, client.HttpSession(), serverConfigurationTemplate, serverConfiguration)
+}
+
+// GetServerConfigurationSettings returns the settings of the server, grouped
+// into sets of related settings, with a description of each. The settings
+// are read-only; they are changed through the configuration they belong to.
+func GetServerConfigurationSettings(client newclient.Client) ([]*ServerConfigurationSettingsSet, error) {
+	return GetServerConfigurationSettingsWithContext(context.Background(), client)
+}
+
+// GetServerConfigurationSettingsWithContext is like GetServerConfigurationSettings, but uses ctx to control cancellation of the HTTP requests.
+func GetServerConfigurationSettingsWithContext(ctx context.Context, client newclient.Client) ([]*ServerConfigurationSettingsSet, error) {
+	resp, err := newclient.GetWithContext[[]*ServerConfigurationSettingsSet](ctx, client.HttpSession(), serverConfigurationSettingsTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return *resp, nil
+}
@@ -0,0 +1,48 @@
+package configuration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerConfiguration(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/serverconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "serverconfiguration", "ServerUri": "https://octopus.example.com"}`))
+	})
+	mux.HandleFunc("PUT /api/serverconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		var serverConfiguration ServerConfiguration
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&serverConfiguration))
+		require.NoError(t, json.NewEncoder(w).Encode(serverConfiguration))
+	})
+	mux.HandleFunc("GET /api/serverconfiguration/settings", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"Name": "Web Portal", "Description": "Settings of the web portal", "ConfigurationValues": [
+			{"Key": "Octopus.WebPortal.ListenPrefixes", "Value": "http://localhost:80/", "ShowInPortalSummary": true},
+			{"Key": "Octopus.WebPortal.ForceSsl", "Value": false}
+		]}]`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	serverConfiguration, err := GetServerConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "https://octopus.example.com", serverConfiguration.ServerURI)
+
+	serverConfiguration.ServerURI = "https://deploy.example.com"
+	serverConfiguration, err = UpdateServerConfiguration(client, serverConfiguration)
+	require.NoError(t, err)
+	require.Equal(t, "https://deploy.example.com", serverConfiguration.ServerURI)
+
+	settings, err := GetServerConfigurationSettings(client)
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	require.Equal(t, "Web Portal", settings[0].Name)
+	require.Len(t, settings[0].ConfigurationValues, 2)
+	require.Equal(t, false, settings[0].ConfigurationValues[1].Value)
+
+	_, err = UpdateServerConfiguration(client, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,26 @@
+package configuration
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// SmtpConfiguration is the SMTP server used to send email notifications.
+type SmtpConfiguration struct {
+	EnableSsl     bool                 `json:"EnableSsl"`
+	SendEmailFrom string               `json:"SendEmailFrom,omitempty"`
+	SmtpHost      string               `json:"SmtpHost,omitempty"`
+	SmtpLogin     string               `json:"SmtpLogin,omitempty"`
+	SmtpPassword  *core.SensitiveValue `json:"SmtpPassword,omitempty"`
+	SmtpPort      int                  `json:"SmtpPort,omitempty"`
+
+	// Timeout is the time to wait for the SMTP server, in milliseconds.
+	Timeout int `json:"Timeout,omitempty"`
+
+	resources.Resource
+}
+
+// SmtpIsConfigured reports whether an SMTP server has been configured.
+type SmtpIsConfigured struct {
+	IsConfigured bool `json:"IsConfigured"`
+}
@@ -1,8 +1,14 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
 	"github.com/dghubble/sling"
 )
 
@@ -18,3 +24,128 @@ func NewSmtpConfigurationService(sling *sling.Sling, uriTemplate string, isConfi
 		Service:          services.NewService(constants.ServiceSMTPConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the SMTP configuration of the server.
+//
+// Deprecated: use configuration.GetSmtpConfiguration
+func (s *SmtpConfigurationService) Get() (*SmtpConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(SmtpConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SmtpConfiguration), nil
+}
+
+// IsConfigured returns whether an SMTP server has been configured.
+//
+// Deprecated: use configuration.IsSmtpConfigured
+func (s *SmtpConfigurationService) IsConfigured() (bool, error) {
+	if internal.IsEmpty(s.isConfiguredPath) {
+		return false, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(SmtpIsConfigured), s.isConfiguredPath)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.(*SmtpIsConfigured).IsConfigured, nil
+}
+
+// Update modifies the SMTP configuration of the server.
+//
+// Deprecated: use configuration.UpdateSmtpConfiguration
+func (s *SmtpConfigurationService) Update(smtpConfiguration *SmtpConfiguration) (*SmtpConfiguration, error) {
+	if smtpConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), smtpConfiguration, new(SmtpConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*SmtpConfiguration), nil
+}
+
+// --- new ---
+
+const (
+	smtpConfigurationTemplate = "/api/smtpconfiguration"
+	smtpIsConfiguredTemplate  = "/api/smtpconfiguration/isconfigured"
+
+	// system tasks, such as sending a test email, are not scoped to a space
+	systemTasksTemplate = "/api/tasks"
+)
+
+// GetSmtpConfiguration returns the SMTP configuration of the server.
+func GetSmtpConfiguration(client newclient.Client) (*SmtpConfiguration, error) {
+	return GetSmtpConfigurationWithContext(context.Background(), client)
+}
+
+// GetSmtpConfigurationWithContext is like GetSmtpConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetSmtpConfigurationWithContext(ctx context.Context, client newclient.Client) (*SmtpConfiguration, error) {
+	return newclient.GetWithContext[SmtpConfiguration](ctx, client.HttpSession(), smtpConfigurationTemplate)
+}
+
+// UpdateSmtpConfiguration modifies the SMTP configuration of the server. The
+// password is only changed if the input sets a new value for it.
+func UpdateSmtpConfiguration(client newclient.Client, smtpConfiguration *SmtpConfiguration) (*SmtpConfiguration, error) {
+	return UpdateSmtpConfigurationWithContext(context.Background(), client, smtpConfiguration)
+}
+
+// UpdateSmtpConfigurationWithContext is like UpdateSmtpConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdateSmtpConfigurationWithContext(ctx context.Context, client newclient.Client, smtpConfiguration *SmtpConfiguration) (*SmtpConfiguration, error) {
+	if smtpConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[SmtpConfiguration](ctx, client.HttpSession(), smtpConfigurationTemplate, smtpConfiguration)
+}
+
+// IsSmtpConfigured returns whether an SMTP server has been configured.
+func IsSmtpConfigured(client newclient.Client) (bool, error) {
+	return IsSmtpConfiguredWithContext(context.Background(), client)
+}
+
+// IsSmtpConfiguredWithContext is like IsSmtpConfigured, but uses ctx to control cancellation of the HTTP requests.
+func IsSmtpConfiguredWithContext(ctx context.Context, client newclient.Client) (bool, error) {
+	resp, err := newclient.GetWithContext[SmtpIsConfigured](ctx, client.HttpSession(), smtpIsConfiguredTemplate)
+	if err != nil {
+		return false, err
+	}
+	return resp.IsConfigured, nil
+}
+
+// SendTestEmail queues a server task which sends an email to the input
+// address using the SMTP configuration of the server. The task belongs to no
+// space, so wait for it with tasks.WaitForTask and WaitOptions.IncludeSystem
+// set to find out whether the email was sent.
+func SendTestEmail(client newclient.Client, emailAddress string) (*tasks.Task, error) {
+	return SendTestEmailWithContext(context.Background(), client, emailAddress)
+}
+
+// SendTestEmailWithContext is like SendTestEmail, but uses ctx to control cancellation of the HTTP requests.
+func SendTestEmailWithContext(ctx context.Context, client newclient.Client, emailAddress string) (*tasks.Task, error) {
+	if internal.IsEmpty(emailAddress) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterEmailAddress)
+	}
+
+	task := tasks.NewTask()
+	task.Name = "TestEmail"
+	task.Description = "Send test email to " + emailAddress
+	task.Arguments["EmailAddress"] = emailAddress
+
+	return newclient.PostWithContext[tasks.Task](ctx, client.HttpSession(), systemTasksTemplate, task)
+}
@@ -0,0 +1,67 @@
+package configuration
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSmtpConfiguration(t *testing.T) {
+	var updated, queued map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/smtpconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "smtp", "SmtpHost": "smtp.example.com", "SmtpPort": 587, "EnableSsl": true, "SmtpPassword": {"HasValue": true}}`))
+	})
+	mux.HandleFunc("PUT /api/smtpconfiguration", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+		_, _ = w.Write([]byte(`{"Id": "smtp", "SmtpHost": "mail.example.com", "SmtpPort": 587}`))
+	})
+	mux.HandleFunc("GET /api/smtpconfiguration/isconfigured", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"IsConfigured": true}`))
+	})
+	mux.HandleFunc("POST /api/tasks", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&queued))
+		_, _ = w.Write([]byte(`{"Id": "ServerTasks-1", "Name": "TestEmail", "State": "Queued"}`))
+	})
+	mux.HandleFunc("GET /api/tasks", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "true", r.URL.Query().Get("includeSystem"))
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-1", "Name": "TestEmail", "State": "Success"}]}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	smtpConfiguration, err := GetSmtpConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "smtp.example.com", smtpConfiguration.SmtpHost)
+	require.True(t, smtpConfiguration.SmtpPassword.HasValue)
+
+	smtpConfiguration.SmtpHost = "mail.example.com"
+	smtpConfiguration.SmtpPassword = core.NewSensitiveValue("secret")
+	smtpConfiguration, err = UpdateSmtpConfiguration(client, smtpConfiguration)
+	require.NoError(t, err)
+	require.Equal(t, "mail.example.com", smtpConfiguration.SmtpHost)
+	require.Equal(t, "secret", updated["SmtpPassword"].(map[string]any)["NewValue"])
+
+	isConfigured, err := IsSmtpConfigured(client)
+	require.NoError(t, err)
+	require.True(t, isConfigured)
+
+	task, err := SendTestEmail(client, "ops@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "ServerTasks-1", task.GetID())
+	require.Equal(t, "TestEmail", queued["Name"])
+	require.Equal(t, map[string]any{"EmailAddress": "ops@example.com"}, queued["Arguments"])
+
+	task, err = tasks.WaitForTask(client, "", task.GetID(), &tasks.WaitOptions{IncludeSystem: true})
+	require.NoError(t, err)
+	require.Equal(t, string(tasks.TaskStateSuccess), task.State)
+
+	_, err = SendTestEmail(client, "")
+	require.Error(t, err)
+	_, err = UpdateSmtpConfiguration(client, nil)
+	require.Error(t, err)
+}
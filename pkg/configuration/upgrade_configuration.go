@@ -0,0 +1,16 @@
+package configuration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+
+// UpgradeConfiguration controls whether the server checks for new versions
+// and how upgrades are announced to users.
+type UpgradeConfiguration struct {
+	AllowChecking     bool `json:"AllowChecking"`
+	IncludeStatistics bool `json:"IncludeStatistics"`
+
+	// NotificationMode is when users are told about a new version, such as
+	// "AlwaysShow", "ShowOnlyMajorMinor" or "NeverShow".
+	NotificationMode string `json:"NotificationMode,omitempty"`
+
+	resources.Resource
+}
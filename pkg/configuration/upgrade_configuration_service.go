@@ -1,8 +1,13 @@
 package configuration
 
 import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
 
@@ -15,3 +20,69 @@ func NewUpgradeConfigurationService(sling *sling.Sling, uriTemplate string) *Upg
 		Service: services.NewService(constants.ServiceUpgradeConfigurationService, sling, uriTemplate),
 	}
 }
+
+// Get returns the upgrade configuration of the server.
+//
+// Deprecated: use configuration.GetUpgradeConfiguration
+func (s *UpgradeConfigurationService) Get() (*UpgradeConfiguration, error) {
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(UpgradeConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UpgradeConfiguration), nil
+}
+
+// Update modifies the upgrade configuration of the server.
+//
+// Deprecated: use configuration.UpdateUpgradeConfiguration
+func (s *UpgradeConfigurationService) Update(upgradeConfiguration *UpgradeConfiguration) (*UpgradeConfiguration, error) {
+	if upgradeConfiguration == nil {
+		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterConfiguration)
+	}
+
+	path, err := services.GetPath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := services.ApiUpdate(s.GetClient(), upgradeConfiguration, new(UpgradeConfiguration), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*UpgradeConfiguration), nil
+}
+
+// --- new ---
+
+const upgradeConfigurationTemplate = "/api/upgradeconfiguration"
+
+// GetUpgradeConfiguration returns the upgrade configuration of the server.
+func GetUpgradeConfiguration(client newclient.Client) (*UpgradeConfiguration, error) {
+	return GetUpgradeConfigurationWithContext(context.Background(), client)
+}
+
+// GetUpgradeConfigurationWithContext is like GetUpgradeConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func GetUpgradeConfigurationWithContext(ctx context.Context, client newclient.Client) (*UpgradeConfiguration, error) {
+	return newclient.GetWithContext[UpgradeConfiguration](ctx, client.HttpSession(), upgradeConfigurationTemplate)
+}
+
+// UpdateUpgradeConfiguration modifies the upgrade configuration of the server.
+func UpdateUpgradeConfiguration(client newclient.Client, upgradeConfiguration *UpgradeConfiguration) (*UpgradeConfiguration, error) {
+	return UpdateUpgradeConfigurationWithContext(context.Background(), client, upgradeConfiguration)
+}
+
+// UpdateUpgradeConfigurationWithContext is like UpdateUpgradeConfiguration, but uses ctx to control cancellation of the HTTP requests.
+func UpdateUpgradeConfigurationWithContext(ctx context.Context, client newclient.Client, upgradeConfiguration *UpgradeConfiguration) (*UpgradeConfiguration, error) {
+	if upgradeConfiguration == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterConfiguration)
+	}
+
+	return newclient.PutWithContext[UpgradeConfiguration](ctx, client.HttpSession(), upgradeConfigurationTemplate, upgradeConfiguration)
+}
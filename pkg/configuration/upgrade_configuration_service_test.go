@@ -0,0 +1,34 @@
+package configuration
+
+import (
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeConfiguration(t *testing.T) {
+	client := testutil.NewTestClient(t, newConfigurationHandler(t, "/api/upgradeconfiguration", `{
+		"Id": "upgrades", "AllowChecking": true, "IncludeStatistics": true, "NotificationMode": "AlwaysShow"
+	}`))
+
+	upgradeConfiguration, err := GetUpgradeConfiguration(client)
+	require.NoError(t, err)
+	require.Equal(t, "upgrades", upgradeConfiguration.GetID())
+	require.True(t, upgradeConfiguration.AllowChecking)
+	require.Equal(t, "AlwaysShow", upgradeConfiguration.NotificationMode)
+
+	upgradeConfiguration.IncludeStatistics = false
+	upgradeConfiguration.NotificationMode = "ShowOnlyMajorMinor"
+	_, err = UpdateUpgradeConfiguration(client, upgradeConfiguration)
+	require.NoError(t, err)
+
+	upgradeConfiguration, err = GetUpgradeConfiguration(client)
+	require.NoError(t, err)
+	require.True(t, upgradeConfiguration.AllowChecking)
+	require.False(t, upgradeConfiguration.IncludeStatistics)
+	require.Equal(t, "ShowOnlyMajorMinor", upgradeConfiguration.NotificationMode)
+
+	_, err = UpdateUpgradeConfiguration(client, nil)
+	require.Error(t, err)
+}
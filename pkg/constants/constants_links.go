@@ -121,6 +121,7 @@ const (
 	LinkTags                              string = "Tags" // git tags for version controlled projects
 	LinkTagSets                           string = "TagSets"
 	LinkTagSetSortOrder                   string = "TagSetSortOrder"
+	LinkTask                              string = "Task"
 	LinkTasks                             string = "Tasks"
 	LinkTaskTypes                         string = "TaskTypes"
 	LinkTeamMembership                    string = "TeamMembership"
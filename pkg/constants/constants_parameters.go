@@ -13,8 +13,11 @@ const (
 	ParameterCertificate            string = "certificate"
 	ParameterCertificateID          string = "certificateID"
 	ParameterChannel                string = "channel"
+	ParameterConfiguration          string = "configuration"
 	ParameterDeploymentTarget       string = "deploymentTarget"
+	ParameterEmailAddress           string = "emailAddress"
 	ParameterEnvironment            string = "environment"
+	ParameterEnvironmentID          string = "environmentID"
 	ParameterFeed                   string = "feed"
 	ParameterGitCredential          string = "gitCredential"
 	ParameterID                     string = "id"
@@ -35,6 +38,7 @@ const (
 	ParameterReplacementCertificate string = "replacementCertificate"
 	ParameterResource               string = "resource"
 	ParameterRunbook                string = "runbook"
+	ParameterRunbookRun             string = "runbookRun"
 	ParameterRunbookSnapshot        string = "runbookSnapshot"
 	ParameterScopedUserRole         string = "scopedUserRole"
 	ParameterScriptModule           string = "scriptModule"
@@ -3,16 +3,26 @@ package core
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // APIError is a generic structure for containing errors for API operations.
+//
+// An APIError can be matched with errors.Is against ErrNotFound, ErrConflict,
+// ErrValidation, ErrUnauthorized, ErrForbidden, ErrRateLimited and
+// ErrServerError, and converted with errors.As into the corresponding
+// *NotFoundError, *ConflictError, *ValidationError, *UnauthorizedError,
+// *ForbiddenError, *RateLimitedError or *ServerError, based on its StatusCode.
 type APIError struct {
-	ErrorMessage    string   `json:"ErrorMessage,omitempty"`
-	Errors          []string `json:"Errors,omitempty"`
-	FullException   string   `json:"FullException,omitempty"`
-	HelpLink        string   `json:"HelpLink,omitempty"`
-	HelpText        string   `json:"HelpText,omitempty"`
-	ParsedHelpLinks []string `json:"ParsedHelpLinks,omitempty"`
+	Details         map[string][]string `json:"Details,omitempty"`
+	ErrorMessage    string              `json:"ErrorMessage,omitempty"`
+	Errors          []string            `json:"Errors,omitempty"`
+	FullException   string              `json:"FullException,omitempty"`
+	HelpLink        string              `json:"HelpLink,omitempty"`
+	HelpText        string              `json:"HelpText,omitempty"`
+	ParsedHelpLinks []string            `json:"ParsedHelpLinks,omitempty"`
+	RetryAfter      time.Duration       `json:"-"`
 	StatusCode      int
 }
 
@@ -21,33 +31,58 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("Octopus API error: %v %+v %v", e.ErrorMessage, e.Errors, e.FullException)
 }
 
+// NewAPIError completes apiError, as decoded from the body of resp, with the
+// status code and any Retry-After header of the response. If apiError is nil,
+// a new APIError is created.
+func NewAPIError(resp *http.Response, apiError *APIError) *APIError {
+	if apiError == nil {
+		apiError = &APIError{}
+	}
+	if resp == nil {
+		return apiError
+	}
+
+	apiError.StatusCode = resp.StatusCode
+	if apiError.ErrorMessage == "" && len(apiError.Errors) == 0 {
+		apiError.ErrorMessage = resp.Status
+	}
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			apiError.RetryAfter = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			apiError.RetryAfter = max(time.Until(date), 0)
+		}
+	}
+	return apiError
+}
+
 // APIErrorChecker is a generic error handler for the OctopusDeploy API.
 func APIErrorChecker(urlPath string, resp *http.Response, wantedResponseCode int, slingError error, octopusDeployError *APIError) error {
-	if octopusDeployError.Errors != nil {
-		return fmt.Errorf("octopus deploy api returned an error on endpoint %s - %s", urlPath, octopusDeployError.Errors)
+	if octopusDeployError.Errors != nil && resp != nil {
+		return NewAPIError(resp, octopusDeployError)
 	}
 
-	if slingError != nil {
-		return fmt.Errorf("cannot get endpoint %s from server. failure from http client %v", urlPath, slingError)
+	if slingError != nil && resp != nil && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		// the error body couldn't be decoded (such as an error page from a proxy), but the status code is still meaningful
+		return NewAPIError(resp, octopusDeployError)
 	}
 
-	defer resp.Body.Close()
-
-	if resp.StatusCode == http.StatusNotFound {
-		octopusDeployError.StatusCode = resp.StatusCode
-		return octopusDeployError
+	if slingError != nil {
+		return fmt.Errorf("cannot get endpoint %s from server. failure from http client %w", urlPath, slingError)
 	}
 
-	if resp.StatusCode == http.StatusBadRequest {
-		return fmt.Errorf("bad request from endpoint %s. response from server %s", urlPath, resp.Status)
+	if resp == nil {
+		return fmt.Errorf("cannot get endpoint %s from server. no response was received", urlPath)
 	}
 
+	defer resp.Body.Close()
+
 	if resp.StatusCode == http.StatusCreated {
 		return nil
 	}
 
 	if resp.StatusCode != wantedResponseCode {
-		return octopusDeployError
+		return NewAPIError(resp, octopusDeployError)
 	}
 
 	return nil
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorIsMatchesStatusCode(t *testing.T) {
+	cases := map[int]error{
+		http.StatusBadRequest:          ErrValidation,
+		http.StatusUnauthorized:        ErrUnauthorized,
+		http.StatusForbidden:           ErrForbidden,
+		http.StatusNotFound:            ErrNotFound,
+		http.StatusConflict:            ErrConflict,
+		http.StatusUnprocessableEntity: ErrValidation,
+		http.StatusTooManyRequests:     ErrRateLimited,
+		http.StatusInternalServerError: ErrServerError,
+		http.StatusServiceUnavailable:  ErrServerError,
+	}
+	sentinels := []error{ErrNotFound, ErrConflict, ErrValidation, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrServerError}
+
+	for statusCode, expected := range cases {
+		err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: statusCode})
+		for _, sentinel := range sentinels {
+			require.Equal(t, sentinel == expected, errors.Is(err, sentinel), "status %d, sentinel %v", statusCode, sentinel)
+		}
+	}
+}
+
+func TestAPIErrorAsTypedErrors(t *testing.T) {
+	var err error = &APIError{StatusCode: http.StatusNotFound, ErrorMessage: "The resource 'Projects-1' was not found."}
+
+	var notFoundError *NotFoundError
+	require.True(t, errors.As(err, &notFoundError))
+	require.Equal(t, "The resource 'Projects-1' was not found.", notFoundError.ErrorMessage)
+
+	var conflictError *ConflictError
+	require.False(t, errors.As(err, &conflictError))
+
+	var apiError *APIError
+	require.True(t, errors.As(notFoundError, &apiError))
+	require.Equal(t, http.StatusNotFound, apiError.StatusCode)
+	require.ErrorIs(t, notFoundError, ErrNotFound)
+}
+
+func TestForbiddenErrorMissingPermission(t *testing.T) {
+	var err error = &APIError{
+		StatusCode:   http.StatusForbidden,
+		ErrorMessage: "You do not have permission to perform this action. Please contact your Octopus administrator. Missing permission: ProjectEdit",
+	}
+
+	var forbiddenError *ForbiddenError
+	require.True(t, errors.As(err, &forbiddenError))
+	require.Equal(t, "ProjectEdit", forbiddenError.MissingPermission)
+}
+
+func TestValidationErrorFieldErrors(t *testing.T) {
+	var validationError *ValidationError
+
+	err := &APIError{StatusCode: http.StatusBadRequest, Details: map[string][]string{"Name": {"Name is required"}}}
+	require.True(t, errors.As(err, &validationError))
+	require.Equal(t, map[string][]string{"Name": {"Name is required"}}, validationError.FieldErrors())
+
+	err = &APIError{StatusCode: http.StatusBadRequest, Errors: []string{"The name is already in use"}}
+	require.True(t, errors.As(err, &validationError))
+	require.Equal(t, map[string][]string{"": {"The name is already in use"}}, validationError.FieldErrors())
+}
+
+func TestNewAPIErrorFromResponse(t *testing.T) {
+	resp := &http.Response{
+		Status:     "429 Too Many Requests",
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"30"}},
+	}
+
+	err := NewAPIError(resp, nil)
+	require.Equal(t, http.StatusTooManyRequests, err.StatusCode)
+	require.Equal(t, "429 Too Many Requests", err.ErrorMessage)
+
+	var rateLimitedError *RateLimitedError
+	require.True(t, errors.As(err, &rateLimitedError))
+	require.Equal(t, 30*time.Second, rateLimitedError.RetryAfter)
+}
+
+func TestAPIErrorCheckerReturnsAPIErrorForBadRequest(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request", Body: http.NoBody}
+
+	err := APIErrorChecker("/api/projects", resp, http.StatusOK, nil, &APIError{ErrorMessage: "There was a problem with your request."})
+	require.ErrorIs(t, err, ErrValidation)
+}
+
+func TestAPIErrorCheckerReturnsErrorWithoutResponse(t *testing.T) {
+	err := APIErrorChecker("/api/projects", nil, http.StatusOK, nil, &APIError{})
+	require.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package core
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrNotFound matches (via errors.Is) an API error for a 404 Not Found response.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict matches (via errors.Is) an API error for a 409 Conflict response.
+	ErrConflict = errors.New("conflict")
+	// ErrValidation matches (via errors.Is) an API error for a 400 Bad Request or 422 Unprocessable Entity response.
+	ErrValidation = errors.New("validation failed")
+	// ErrUnauthorized matches (via errors.Is) an API error for a 401 Unauthorized response.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden matches (via errors.Is) an API error for a 403 Forbidden response.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited matches (via errors.Is) an API error for a 429 Too Many Requests response.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServerError matches (via errors.Is) an API error for a 5xx response.
+	ErrServerError = errors.New("server error")
+)
+
+var missingPermissionPattern = regexp.MustCompile(`Missing permissions?:\s*([A-Za-z0-9, ]+)`)
+
+// NotFoundError is an API error for a 404 Not Found response.
+type NotFoundError struct {
+	*APIError
+}
+
+// ConflictError is an API error for a 409 Conflict response.
+type ConflictError struct {
+	*APIError
+}
+
+// ValidationError is an API error for a request which the server rejected as
+// invalid.
+type ValidationError struct {
+	*APIError
+}
+
+// FieldErrors returns the validation messages keyed by the name of the field
+// they relate to. Messages which the server did not associate with a field are
+// keyed by the empty string.
+func (e *ValidationError) FieldErrors() map[string][]string {
+	fieldErrors := map[string][]string{}
+	for field, messages := range e.Details {
+		fieldErrors[field] = append(fieldErrors[field], messages...)
+	}
+	if len(e.Details) == 0 && len(e.Errors) > 0 {
+		fieldErrors[""] = append(fieldErrors[""], e.Errors...)
+	}
+	return fieldErrors
+}
+
+// UnauthorizedError is an API error for a 401 Unauthorized response, typically
+// caused by a missing, invalid or expired API key.
+type UnauthorizedError struct {
+	*APIError
+}
+
+// ForbiddenError is an API error for a 403 Forbidden response.
+type ForbiddenError struct {
+	*APIError
+
+	// MissingPermission is the permission the server reported as missing, if any.
+	MissingPermission string
+}
+
+// RateLimitedError is an API error for a 429 Too Many Requests response. Its
+// RetryAfter field holds the wait requested by the server, if any.
+type RateLimitedError struct {
+	*APIError
+}
+
+// ServerError is an API error for a 5xx response.
+type ServerError struct {
+	*APIError
+}
+
+// Is allows errors.Is to match an APIError against ErrNotFound, ErrConflict,
+// ErrValidation, ErrUnauthorized, ErrForbidden, ErrRateLimited or
+// ErrServerError.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError && e.StatusCode <= 599
+	}
+	return false
+}
+
+// As allows errors.As to convert an APIError into the typed error that
+// corresponds to its status code.
+func (e *APIError) As(target any) bool {
+	switch t := target.(type) {
+	case **APIError:
+		*t = e
+		return true
+	case **NotFoundError:
+		if e.Is(ErrNotFound) {
+			*t = &NotFoundError{APIError: e}
+			return true
+		}
+	case **ConflictError:
+		if e.Is(ErrConflict) {
+			*t = &ConflictError{APIError: e}
+			return true
+		}
+	case **ValidationError:
+		if e.Is(ErrValidation) {
+			*t = &ValidationError{APIError: e}
+			return true
+		}
+	case **UnauthorizedError:
+		if e.Is(ErrUnauthorized) {
+			*t = &UnauthorizedError{APIError: e}
+			return true
+		}
+	case **ForbiddenError:
+		if e.Is(ErrForbidden) {
+			*t = &ForbiddenError{APIError: e, MissingPermission: e.missingPermission()}
+			return true
+		}
+	case **RateLimitedError:
+		if e.Is(ErrRateLimited) {
+			*t = &RateLimitedError{APIError: e}
+			return true
+		}
+	case **ServerError:
+		if e.Is(ErrServerError) {
+			*t = &ServerError{APIError: e}
+			return true
+		}
+	}
+	return false
+}
+
+// missingPermission extracts the permission from messages such as
+// "You do not have permission to perform this action. Missing permission: ProjectEdit".
+func (e *APIError) missingPermission() string {
+	for _, message := range append([]string{e.ErrorMessage}, e.Errors...) {
+		if match := missingPermissionPattern.FindStringSubmatch(message); match != nil {
+			return strings.TrimSpace(match[1])
+		}
+	}
+	return ""
+}
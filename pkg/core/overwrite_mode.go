@@ -0,0 +1,11 @@
+package core
+
+// OverwriteMode instructs the server what to do when a package or the build
+// information of a package that is pushed to it already exists.
+type OverwriteMode string
+
+const (
+	OverwriteModeFailIfExists      = OverwriteMode("FailIfExists")
+	OverwriteModeIgnoreIfExists    = OverwriteMode("IgnoreIfExists")
+	OverwriteModeOverwriteExisting = OverwriteMode("OverwriteExisting")
+)
@@ -1,6 +1,8 @@
 package credentials
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -162,32 +164,69 @@ const template = "/api/{spaceId}/git-credentials{/id}{?skip,take,name}"
 
 // Add creates a new resource.
 func Add(client newclient.Client, resource *Resource) (*Resource, error) {
-	return newclient.Add[Resource](client, template, resource.SpaceID, resource)
+	return AddWithContext(context.Background(), client, resource)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, resource *Resource) (*Resource, error) {
+	return newclient.AddWithContext[Resource](ctx, client, template, resource.SpaceID, resource)
 }
 
 // Get returns a collection of environments based on the criteria defined by
 // its input query parameter. If an error occurs, an empty collection is
 // returned along with the associated error.
 func Get(client newclient.Client, spaceID string, query Query) (*resources.Resources[*Resource], error) {
-	return newclient.GetByQuery[Resource](client, template, spaceID, query)
+	return GetWithContext(context.Background(), client, spaceID, query)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, query Query) (*resources.Resources[*Resource], error) {
+	return newclient.GetByQueryWithContext[Resource](ctx, client, template, spaceID, query)
+}
+
+// Iterate returns an iterator over the Git credentials matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query Query) iter.Seq2[*Resource, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query Query) iter.Seq2[*Resource, error] {
+	return newclient.IterateByQueryWithContext[Resource](ctx, client, template, spaceID, query)
 }
 
 // GetByID returns the Git credential that matches the input ID. If one cannot be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Resource, error) {
-	return newclient.GetByID[Resource](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Resource, error) {
+	return newclient.GetByIDWithContext[Resource](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a Git credential based on the one provided as input.
 func Update(client newclient.Client, gitCredential *Resource) (*Resource, error) {
-	_, err := newclient.Update[Resource](client, template, gitCredential.SpaceID, gitCredential.GetID(), gitCredential)
+	return UpdateWithContext(context.Background(), client, gitCredential)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, gitCredential *Resource) (*Resource, error) {
+	_, err := newclient.UpdateWithContext[Resource](ctx, client, template, gitCredential.SpaceID, gitCredential.GetID(), gitCredential)
 	if err != nil {
 		return nil, err
 	}
 	// TODO: remove this once the API is fixed
-	return GetByID(client, gitCredential.SpaceID, gitCredential.GetID())
+	return GetByIDWithContext(ctx, client, gitCredential.SpaceID, gitCredential.GetID())
 }
 
 // DeleteByID deletes a Git credential based on the provided ID.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
@@ -1,6 +1,7 @@
 package deployments
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -65,6 +66,11 @@ func (c *CreateDeploymentTenantedCommandV1) MarshalJSON() ([]byte, error) {
 }
 
 func CreateDeploymentTenantedV1(client newclient.Client, command *CreateDeploymentTenantedCommandV1) (*CreateDeploymentResponseV1, error) {
+	return CreateDeploymentTenantedV1WithContext(context.Background(), client, command)
+}
+
+// CreateDeploymentTenantedV1WithContext is like CreateDeploymentTenantedV1, but uses ctx to control cancellation of the HTTP requests.
+func CreateDeploymentTenantedV1WithContext(ctx context.Context, client newclient.Client, command *CreateDeploymentTenantedCommandV1) (*CreateDeploymentResponseV1, error) {
 	if command == nil {
 		return nil, internal.CreateInvalidParameterError("CreateDeploymentTenantedV1", "command")
 	}
@@ -82,7 +88,7 @@ func CreateDeploymentTenantedV1(client newclient.Client, command *CreateDeployme
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Post[CreateDeploymentResponseV1](client.HttpSession(), expandedUri, command)
+	return newclient.PostWithContext[CreateDeploymentResponseV1](ctx, client.HttpSession(), expandedUri, command)
 }
 
 // ----- Untenanted -----------------------------------------------
@@ -117,6 +123,11 @@ func (c *CreateDeploymentUntenantedCommandV1) MarshalJSON() ([]byte, error) {
 }
 
 func CreateDeploymentUntenantedV1(client newclient.Client, command *CreateDeploymentUntenantedCommandV1) (*CreateDeploymentResponseV1, error) {
+	return CreateDeploymentUntenantedV1WithContext(context.Background(), client, command)
+}
+
+// CreateDeploymentUntenantedV1WithContext is like CreateDeploymentUntenantedV1, but uses ctx to control cancellation of the HTTP requests.
+func CreateDeploymentUntenantedV1WithContext(ctx context.Context, client newclient.Client, command *CreateDeploymentUntenantedCommandV1) (*CreateDeploymentResponseV1, error) {
 	if command == nil {
 		return nil, internal.CreateInvalidParameterError("CreateDeploymentUntenantedV1", "command")
 	}
@@ -134,5 +145,5 @@ func CreateDeploymentUntenantedV1(client newclient.Client, command *CreateDeploy
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Post[CreateDeploymentResponseV1](client.HttpSession(), expandedUri, command)
+	return newclient.PostWithContext[CreateDeploymentResponseV1](ctx, client.HttpSession(), expandedUri, command)
 }
@@ -0,0 +1,245 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/interruptions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+// DeploymentOutcomeState summarizes how a deployment ended. It is the state
+// of the deployment's task, as reported by tasks.FollowTask.
+type DeploymentOutcomeState = tasks.OutcomeState
+
+const (
+	DeploymentOutcomeSucceeded   = tasks.OutcomeSucceeded
+	DeploymentOutcomeFailed      = tasks.OutcomeFailed
+	DeploymentOutcomeInterrupted = tasks.OutcomeInterrupted
+	DeploymentOutcomeIncomplete  = tasks.OutcomeIncomplete
+)
+
+// GuidedFailurePolicy decides how to respond to a guided failure prompt
+// raised by a deployment. It returns one of interruptions.GuidedFailureRetry,
+// GuidedFailureIgnore, GuidedFailureExclude or GuidedFailureAbort, with notes
+// to record against the response, or an empty guidance to leave the prompt
+// for a person to answer. Policies are called from several goroutines, so
+// must be safe for concurrent use.
+type GuidedFailurePolicy func(outcome *DeploymentOutcome, interruption *interruptions.Interruption) (guidance string, notes string)
+
+// DeployOptions controls how DeployUntenanted and DeployTenanted follow the
+// deployments they create.
+type DeployOptions struct {
+	// Wait controls how each deployment's task is polled. Its Timeout
+	// applies to each deployment separately, and its OnPoll is called from
+	// several goroutines, so must be safe for concurrent use.
+	Wait *tasks.WaitOptions
+
+	// GuidedFailurePolicy, if set, responds to guided failure prompts as
+	// they are raised. Manual interventions are always left for a person.
+	GuidedFailurePolicy GuidedFailurePolicy
+}
+
+// GuidedFailureResponse records a response made to a guided failure prompt
+// on behalf of a GuidedFailurePolicy.
+type GuidedFailureResponse struct {
+	InterruptionID string
+	Guidance       string
+}
+
+// DeploymentOutcome is the result of one deployment created by
+// DeployUntenanted or DeployTenanted.
+type DeploymentOutcome struct {
+	DeploymentID  string
+	ServerTaskID  string
+	EnvironmentID string
+
+	// TenantID is empty for untenanted deployments.
+	TenantID string
+	State    DeploymentOutcomeState
+
+	// Task is the last state of the deployment's task that was seen.
+	Task                   *tasks.Task
+	GuidedFailureResponses []*GuidedFailureResponse
+
+	// Err is set when the deployment did not succeed, or could not be
+	// followed to its end.
+	Err error
+}
+
+// DeployResult is the result of the deployments created by DeployUntenanted
+// or DeployTenanted, in the order the server created them.
+type DeployResult struct {
+	Outcomes []*DeploymentOutcome
+}
+
+// Succeeded reports whether every deployment succeeded.
+func (r *DeployResult) Succeeded() bool {
+	for _, outcome := range r.Outcomes {
+		if outcome.State != DeploymentOutcomeSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// ByEnvironment groups the outcomes by the ID of the environment deployed to.
+func (r *DeployResult) ByEnvironment() map[string][]*DeploymentOutcome {
+	return r.groupBy(func(outcome *DeploymentOutcome) string { return outcome.EnvironmentID })
+}
+
+// ByTenant groups the outcomes by the ID of the tenant deployed for. The
+// outcomes of untenanted deployments are grouped under the empty string.
+func (r *DeployResult) ByTenant() map[string][]*DeploymentOutcome {
+	return r.groupBy(func(outcome *DeploymentOutcome) string { return outcome.TenantID })
+}
+
+func (r *DeployResult) groupBy(key func(*DeploymentOutcome) string) map[string][]*DeploymentOutcome {
+	groups := map[string][]*DeploymentOutcome{}
+	for _, outcome := range r.Outcomes {
+		groups[key(outcome)] = append(groups[key(outcome)], outcome)
+	}
+	return groups
+}
+
+// DeployUntenanted creates untenanted deployments of a release, then waits for
+// all of them to complete and reports the outcome of each. The deployments
+// are followed concurrently. An error is returned only if the deployments
+// could not be created or ctx is done; the success or failure of each
+// deployment is reported in its outcome.
+func DeployUntenanted(client newclient.Client, command *CreateDeploymentUntenantedCommandV1, options *DeployOptions) (*DeployResult, error) {
+	return DeployUntenantedWithContext(context.Background(), client, command, options)
+}
+
+// DeployUntenantedWithContext is like DeployUntenanted, but uses ctx to control cancellation of the HTTP requests.
+func DeployUntenantedWithContext(ctx context.Context, client newclient.Client, command *CreateDeploymentUntenantedCommandV1, options *DeployOptions) (*DeployResult, error) {
+	response, err := CreateDeploymentUntenantedV1WithContext(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	return waitForDeployments(ctx, client, command.SpaceID, response, options)
+}
+
+// DeployTenanted creates tenanted deployments of a release, then waits for
+// all of them to complete and reports the outcome of each, as
+// DeployUntenanted does.
+func DeployTenanted(client newclient.Client, command *CreateDeploymentTenantedCommandV1, options *DeployOptions) (*DeployResult, error) {
+	return DeployTenantedWithContext(context.Background(), client, command, options)
+}
+
+// DeployTenantedWithContext is like DeployTenanted, but uses ctx to control cancellation of the HTTP requests.
+func DeployTenantedWithContext(ctx context.Context, client newclient.Client, command *CreateDeploymentTenantedCommandV1, options *DeployOptions) (*DeployResult, error) {
+	response, err := CreateDeploymentTenantedV1WithContext(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	return waitForDeployments(ctx, client, command.SpaceID, response, options)
+}
+
+func waitForDeployments(ctx context.Context, client newclient.Client, spaceID string, response *CreateDeploymentResponseV1, options *DeployOptions) (*DeployResult, error) {
+	if options == nil {
+		options = &DeployOptions{}
+	}
+
+	result := &DeployResult{Outcomes: make([]*DeploymentOutcome, len(response.DeploymentServerTasks))}
+	var wg sync.WaitGroup
+	for i, deploymentServerTask := range response.DeploymentServerTasks {
+		outcome := &DeploymentOutcome{
+			DeploymentID: deploymentServerTask.DeploymentID,
+			ServerTaskID: deploymentServerTask.ServerTaskID,
+			State:        DeploymentOutcomeIncomplete,
+		}
+		result.Outcomes[i] = outcome
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			followDeployment(ctx, client, spaceID, outcome, options)
+		}()
+	}
+	wg.Wait()
+
+	return result, ctx.Err()
+}
+
+// followDeployment waits for a deployment's task to complete, responding to
+// guided failure prompts as it goes, and records how it ended in outcome.
+func followDeployment(ctx context.Context, client newclient.Client, spaceID string, outcome *DeploymentOutcome, options *DeployOptions) {
+	deployment, err := GetDeploymentByIDWithContext(ctx, client, spaceID, outcome.DeploymentID)
+	if err != nil {
+		outcome.Err = err
+		return
+	}
+	outcome.EnvironmentID = deployment.EnvironmentID
+	outcome.TenantID = deployment.TenantID
+
+	// a failure to respond to a prompt ends the wait, as the deployment would otherwise stall
+	waitCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	waitOptions := tasks.WaitOptions{}
+	if options.Wait != nil {
+		waitOptions = *options.Wait
+	}
+	onPoll := waitOptions.OnPoll
+	handled := map[string]bool{}
+	waitOptions.OnPoll = func(task *tasks.Task) {
+		outcome.Task = task
+		if onPoll != nil {
+			onPoll(task)
+		}
+		if options.GuidedFailurePolicy != nil && task.HasPendingInterruptions {
+			if err := respondToGuidedFailures(waitCtx, client, spaceID, outcome, options.GuidedFailurePolicy, handled); err != nil {
+				cancel(err)
+			}
+		}
+	}
+
+	followed := tasks.FollowTaskWithContext(waitCtx, client, spaceID, outcome.ServerTaskID, &waitOptions)
+	outcome.State = followed.State
+	outcome.Task = followed.Task
+	outcome.Err = followed.Err
+}
+
+func respondToGuidedFailures(ctx context.Context, client newclient.Client, spaceID string, outcome *DeploymentOutcome, policy GuidedFailurePolicy, handled map[string]bool) error {
+	pending, err := interruptions.GetPendingForTaskWithContext(ctx, client, spaceID, outcome.ServerTaskID)
+	if err != nil {
+		return err
+	}
+
+	for _, interruption := range pending {
+		if handled[interruption.GetID()] || interruption.Type() != interruptions.InterruptionTypeGuidedFailure {
+			continue
+		}
+		handled[interruption.GetID()] = true
+
+		guidance, notes := policy(outcome, interruption)
+		var respond func(context.Context, newclient.Client, *interruptions.Interruption, string) (*interruptions.Interruption, error)
+		switch guidance {
+		case "":
+			continue
+		case interruptions.GuidedFailureRetry:
+			respond = interruptions.RetryWithContext
+		case interruptions.GuidedFailureIgnore:
+			respond = interruptions.IgnoreWithContext
+		case interruptions.GuidedFailureExclude:
+			respond = interruptions.ExcludeWithContext
+		case interruptions.GuidedFailureAbort:
+			respond = interruptions.AbortWithContext
+		default:
+			return internal.CreateInvalidParameterError("GuidedFailurePolicy", fmt.Sprintf("guidance %q", guidance))
+		}
+
+		if _, err := respond(ctx, client, interruption, notes); err != nil {
+			return err
+		}
+		outcome.GuidedFailureResponses = append(outcome.GuidedFailureResponses, &GuidedFailureResponse{
+			InterruptionID: interruption.GetID(),
+			Guidance:       guidance,
+		})
+	}
+	return nil
+}
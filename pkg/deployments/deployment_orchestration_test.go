@@ -0,0 +1,136 @@
+package deployments_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/interruptions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+const pendingGuidedFailure = `{"Items": [{
+	"Id": "Interruptions-1",
+	"SpaceId": "Spaces-1",
+	"IsPending": true,
+	"HasResponsibility": true,
+	"TaskId": "ServerTasks-1",
+	"Form": {"Elements": [
+		{"Name": "Notes", "Control": {"Type": "TextArea"}},
+		{"Name": "Guidance", "Control": {"Type": "SubmitButtonGroup", "Buttons": [{"Value": "Abort"}, {"Value": "Retry"}, {"Value": "Ignore"}, {"Value": "Exclude"}]}}
+	]}
+}], "Links": {}}`
+
+// fakeDeploymentServer creates a deployment for each of two tenants. The
+// first raises a guided failure prompt, which must be answered before it
+// succeeds; the second fails.
+type fakeDeploymentServer struct {
+	mu        sync.Mutex
+	submitted map[string]string
+}
+
+func (s *fakeDeploymentServer) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/deployments/create/tenanted/v1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"DeploymentServerTasks": [
+			{"DeploymentId": "Deployments-1", "ServerTaskId": "ServerTasks-1"},
+			{"DeploymentId": "Deployments-2", "ServerTaskId": "ServerTasks-2"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/deployments/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tenantID := map[string]string{"Deployments-1": "Tenants-1", "Deployments-2": "Tenants-2"}[r.PathValue("id")]
+		_, _ = fmt.Fprintf(w, `{"Id": %q, "EnvironmentId": "Environments-1", "TenantId": %q}`, r.PathValue("id"), tenantID)
+	})
+	mux.HandleFunc("GET /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.URL.Query().Get("ids") {
+		case "ServerTasks-1":
+			if s.submitted == nil {
+				_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-1", "State": "Executing", "HasPendingInterruptions": true}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-1", "State": "Success"}]}`))
+		case "ServerTasks-2":
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-2", "State": "Failed", "ErrorMessage": "the script failed"}]}`))
+		}
+	})
+	mux.HandleFunc("GET /api/Spaces-1/interruptions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ServerTasks-1", r.URL.Query().Get("regarding"))
+		_, _ = w.Write([]byte(pendingGuidedFailure))
+	})
+	mux.HandleFunc("POST /api/Spaces-1/interruptions/Interruptions-1/submit", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&s.submitted))
+		_, _ = w.Write([]byte(`{"Id": "Interruptions-1"}`))
+	})
+	return mux
+}
+
+func newTenantedTestCommand() *deployments.CreateDeploymentTenantedCommandV1 {
+	command := deployments.NewCreateDeploymentTenantedCommandV1("Spaces-1", "Projects-1")
+	command.ReleaseVersion = "1.0.0"
+	command.EnvironmentName = "Environments-1"
+	command.Tenants = []string{"Tenants-1", "Tenants-2"}
+	return command
+}
+
+func TestDeployTenanted(t *testing.T) {
+	server := &fakeDeploymentServer{}
+	client := testutil.NewTestClient(t, server.handler(t))
+
+	var policyCalls int
+	result, err := deployments.DeployTenanted(client, newTenantedTestCommand(), &deployments.DeployOptions{
+		Wait: &tasks.WaitOptions{PollInterval: time.Millisecond},
+		GuidedFailurePolicy: func(outcome *deployments.DeploymentOutcome, interruption *interruptions.Interruption) (string, string) {
+			policyCalls++
+			require.Equal(t, "Tenants-1", outcome.TenantID)
+			return interruptions.GuidedFailureRetry, "retried automatically"
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Succeeded())
+	require.Len(t, result.Outcomes, 2)
+	require.Equal(t, 1, policyCalls)
+	require.Equal(t, map[string]string{"Guidance": "Retry", "Notes": "retried automatically"}, server.submitted)
+
+	first := result.Outcomes[0]
+	require.Equal(t, "Deployments-1", first.DeploymentID)
+	require.Equal(t, deployments.DeploymentOutcomeSucceeded, first.State)
+	require.NoError(t, first.Err)
+	require.Equal(t, []*deployments.GuidedFailureResponse{{InterruptionID: "Interruptions-1", Guidance: "Retry"}}, first.GuidedFailureResponses)
+
+	second := result.Outcomes[1]
+	require.Equal(t, deployments.DeploymentOutcomeFailed, second.State)
+	require.ErrorIs(t, second.Err, tasks.ErrTaskFailed)
+
+	byTenant := result.ByTenant()
+	require.Equal(t, []*deployments.DeploymentOutcome{first}, byTenant["Tenants-1"])
+	require.Equal(t, []*deployments.DeploymentOutcome{second}, byTenant["Tenants-2"])
+	require.Len(t, result.ByEnvironment()["Environments-1"], 2)
+}
+
+func TestDeployTenantedReportsInterruptedDeployments(t *testing.T) {
+	server := &fakeDeploymentServer{}
+	client := testutil.NewTestClient(t, server.handler(t))
+
+	// without a policy the guided failure prompt is left for a person, so the wait times out
+	result, err := deployments.DeployTenantedWithContext(context.Background(), client, newTenantedTestCommand(), &deployments.DeployOptions{
+		Wait: &tasks.WaitOptions{PollInterval: time.Millisecond, Timeout: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.Nil(t, server.submitted)
+
+	first := result.Outcomes[0]
+	require.Equal(t, deployments.DeploymentOutcomeInterrupted, first.State)
+	require.ErrorIs(t, first.Err, context.DeadlineExceeded)
+	require.Equal(t, deployments.DeploymentOutcomeFailed, result.Outcomes[1].State)
+}
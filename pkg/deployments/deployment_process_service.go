@@ -1,6 +1,7 @@
 package deployments
 
 import (
+	"context"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -142,18 +143,28 @@ const (
 // GetDeploymentProcessByID fetches a deployment process. This may either be the project level process (template),
 // or a process snapshot from a Release, depending on the value of ID
 func GetDeploymentProcessByID(client newclient.Client, spaceID string, ID string) (*DeploymentProcess, error) {
-	return newclient.GetByID[DeploymentProcess](client, deploymentProcessesTemplate, spaceID, ID)
+	return GetDeploymentProcessByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetDeploymentProcessByIDWithContext is like GetDeploymentProcessByID, but uses ctx to control cancellation of the HTTP requests.
+func GetDeploymentProcessByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*DeploymentProcess, error) {
+	return newclient.GetByIDWithContext[DeploymentProcess](ctx, client, deploymentProcessesTemplate, spaceID, ID)
 }
 
 // GetDeploymentProcessByGitRef returns the deployment process that matches the input project and
 // a git reference.
 func GetDeploymentProcessByGitRef(client newclient.Client, spaceID string, project *projects.Project, gitRef string) (*DeploymentProcess, error) {
+	return GetDeploymentProcessByGitRefWithContext(context.Background(), client, spaceID, project, gitRef)
+}
+
+// GetDeploymentProcessByGitRefWithContext is like GetDeploymentProcessByGitRef, but uses ctx to control cancellation of the HTTP requests.
+func GetDeploymentProcessByGitRefWithContext(ctx context.Context, client newclient.Client, spaceID string, project *projects.Project, gitRef string) (*DeploymentProcess, error) {
 	if project == nil {
 		return nil, internal.CreateInvalidParameterError("GetByGitRef", "project")
 	}
 
 	if project.PersistenceSettings == nil || project.PersistenceSettings.Type() != projects.PersistenceSettingsTypeVersionControlled {
-		return GetDeploymentProcessByID(client, spaceID, project.DeploymentProcessID)
+		return GetDeploymentProcessByIDWithContext(ctx, client, spaceID, project.DeploymentProcessID)
 	}
 
 	gitPersistenceSettings := project.PersistenceSettings.(projects.GitPersistenceSettings)
@@ -166,7 +177,7 @@ func GetDeploymentProcessByGitRef(client newclient.Client, spaceID string, proje
 	template, _ := uritemplates.Parse(project.Links["DeploymentProcess"])
 	path, _ := template.Expand(map[string]interface{}{"gitRef": gitRef})
 
-	deploymentProcess, err := newclient.Get[DeploymentProcess](client.HttpSession(), path)
+	deploymentProcess, err := newclient.GetWithContext[DeploymentProcess](ctx, client.HttpSession(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -178,12 +189,17 @@ func GetDeploymentProcessByGitRef(client newclient.Client, spaceID string, proje
 
 // UpdateDeploymentProcess modifies a deployment process based on the one provided as input.
 func UpdateDeploymentProcess(client newclient.Client, deploymentProcess *DeploymentProcess) (*DeploymentProcess, error) {
+	return UpdateDeploymentProcessWithContext(context.Background(), client, deploymentProcess)
+}
+
+// UpdateDeploymentProcessWithContext is like UpdateDeploymentProcess, but uses ctx to control cancellation of the HTTP requests.
+func UpdateDeploymentProcessWithContext(ctx context.Context, client newclient.Client, deploymentProcess *DeploymentProcess) (*DeploymentProcess, error) {
 	if deploymentProcess == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, "deploymentProcess")
 	}
 
 	// TODO: remove use of links
-	deploymentProcessTemplate, err := newclient.Update[DeploymentProcess](client, deploymentProcess.Links["Self"], deploymentProcess.SpaceID, deploymentProcess.ID, deploymentProcess)
+	deploymentProcessTemplate, err := newclient.UpdateWithContext[DeploymentProcess](ctx, client, deploymentProcess.Links["Self"], deploymentProcess.SpaceID, deploymentProcess.ID, deploymentProcess)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +208,11 @@ func UpdateDeploymentProcess(client newclient.Client, deploymentProcess *Deploym
 }
 
 func GetDeploymentProcessTemplate(client newclient.Client, deploymentProcess *DeploymentProcess, channelID string, releaseID string) (*DeploymentProcessTemplate, error) {
+	return GetDeploymentProcessTemplateWithContext(context.Background(), client, deploymentProcess, channelID, releaseID)
+}
+
+// GetDeploymentProcessTemplateWithContext is like GetDeploymentProcessTemplate, but uses ctx to control cancellation of the HTTP requests.
+func GetDeploymentProcessTemplateWithContext(ctx context.Context, client newclient.Client, deploymentProcess *DeploymentProcess, channelID string, releaseID string) (*DeploymentProcessTemplate, error) {
 	if deploymentProcess == nil {
 		return nil, internal.CreateInvalidParameterError("GetTemplate", "deploymentProcess")
 	}
@@ -210,7 +231,7 @@ func GetDeploymentProcessTemplate(client newclient.Client, deploymentProcess *De
 
 	path, _ := template.Expand(values)
 
-	deploymentProcessTemplate, err := newclient.Get[DeploymentProcessTemplate](client.HttpSession(), path)
+	deploymentProcessTemplate, err := newclient.GetWithContext[DeploymentProcessTemplate](ctx, client.HttpSession(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -221,5 +242,10 @@ func GetDeploymentProcessTemplate(client newclient.Client, deploymentProcess *De
 // GetAllDeploymentProcesses returns all deployment processes. If none can be found or an error
 // occurs, it returns an empty collection.
 func GetAllDeploymentProcesses(client newclient.Client, spaceID string) ([]*DeploymentProcess, error) {
-	return newclient.GetAll[DeploymentProcess](client, deploymentProcessesTemplate, spaceID)
+	return GetAllDeploymentProcessesWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllDeploymentProcessesWithContext is like GetAllDeploymentProcesses, but uses ctx to control cancellation of the HTTP requests.
+func GetAllDeploymentProcessesWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*DeploymentProcess, error) {
+	return newclient.GetAllWithContext[DeploymentProcess](ctx, client, deploymentProcessesTemplate, spaceID)
 }
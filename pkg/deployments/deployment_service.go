@@ -1,6 +1,7 @@
 package deployments
 
 import (
+	"context"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -50,6 +51,8 @@ func (s *DeploymentService) Add(deployment *Deployment) (*Deployment, error) {
 
 // GetByID gets a deployment that matches the input ID. If one cannot be found,
 // it returns nil and an error.
+//
+// Deprecated: use deployments.GetDeploymentByID
 func (s *DeploymentService) GetByID(id string) (*Deployment, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
@@ -142,6 +145,10 @@ func (s *DeploymentService) GetDeployments(release *releases.Release, deployment
 	return resp.(*resources.Resources[*Deployment]), nil
 }
 
+// GetProgression returns the progression of a release through the phases of
+// its channel's lifecycle.
+//
+// Deprecated: use releases.GetProgression
 func (s *DeploymentService) GetProgression(release *releases.Release) (*releases.LifecycleProgression, error) {
 	if release == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetDeployments, constants.ParameterRelease)
@@ -193,6 +200,11 @@ func (s *DeploymentService) GetDeploymentSettings(project *projects.Project, git
 // This is used by the portal to show which machines would be deployed to, and other information about the deployment,
 // before proceeding with it. The CLI uses it to build the selector for picking specific machines to deploy to
 func GetReleaseDeploymentPreview(client newclient.Client, spaceID string, releaseID string, environmentID string, includeDisabledSteps bool) (*DeploymentPreview, error) {
+	return GetReleaseDeploymentPreviewWithContext(context.Background(), client, spaceID, releaseID, environmentID, includeDisabledSteps)
+}
+
+// GetReleaseDeploymentPreviewWithContext is like GetReleaseDeploymentPreview, but uses ctx to control cancellation of the HTTP requests.
+func GetReleaseDeploymentPreviewWithContext(ctx context.Context, client newclient.Client, spaceID string, releaseID string, environmentID string, includeDisabledSteps bool) (*DeploymentPreview, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetReleaseDeploymentPreview", "client")
 	}
@@ -215,13 +227,18 @@ func GetReleaseDeploymentPreview(client newclient.Client, spaceID string, releas
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[DeploymentPreview](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[DeploymentPreview](ctx, client.HttpSession(), expandedUri)
 }
 
 // GetReleaseDeploymentPreviews gets a preview of a release for a multiple given environments.
 // This is used by the portal to show which machines, prompted variables and other information about the deployment,
 // before proceeding with it. The CLI uses it to build the prompted variables list for a deployment to a given set of environments
 func GetReleaseDeploymentPreviews(client newclient.Client, spaceID string, releaseID string, deploymentPreviewRequests []DeploymentPreviewRequest, includeDisabledSteps bool) ([]*DeploymentPreview, error) {
+	return GetReleaseDeploymentPreviewsWithContext(context.Background(), client, spaceID, releaseID, deploymentPreviewRequests, includeDisabledSteps)
+}
+
+// GetReleaseDeploymentPreviewsWithContext is like GetReleaseDeploymentPreviews, but uses ctx to control cancellation of the HTTP requests.
+func GetReleaseDeploymentPreviewsWithContext(ctx context.Context, client newclient.Client, spaceID string, releaseID string, deploymentPreviewRequests []DeploymentPreviewRequest, includeDisabledSteps bool) ([]*DeploymentPreview, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetReleaseDeploymentPreviews", "client")
 	}
@@ -251,7 +268,20 @@ func GetReleaseDeploymentPreviews(client newclient.Client, spaceID string, relea
 		SpaceId:              spaceID,
 	}
 
-	test, err := newclient.Post[[]*DeploymentPreview](client.HttpSession(), expandedUri, body)
+	test, err := newclient.PostWithContext[[]*DeploymentPreview](ctx, client.HttpSession(), expandedUri, body)
 
 	return *test, err
 }
+
+const deploymentsTemplate = "/api/{spaceId}/deployments{/id}{?skip,take,ids,projects,environments,tenants,channels,taskState}"
+
+// GetDeploymentByID returns the deployment that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func GetDeploymentByID(client newclient.Client, spaceID string, ID string) (*Deployment, error) {
+	return GetDeploymentByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetDeploymentByIDWithContext is like GetDeploymentByID, but uses ctx to control cancellation of the HTTP requests.
+func GetDeploymentByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Deployment, error) {
+	return newclient.GetByIDWithContext[Deployment](ctx, client, deploymentsTemplate, spaceID, ID)
+}
@@ -0,0 +1,255 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+// ErrNoEligibleEnvironments is returned by PromoteRelease when there is no
+// environment the release can be promoted to.
+var ErrNoEligibleEnvironments = errors.New("the release cannot be promoted to any environment")
+
+// PromotionPlan describes where a release stands in its lifecycle, and where
+// it can be deployed next.
+type PromotionPlan struct {
+	ReleaseID string
+
+	// NextEnvironmentIDs are the environments the lifecycle allows the
+	// release to be deployed to now, excluding those it has already been
+	// deployed to successfully.
+	NextEnvironmentIDs []string
+
+	// MinimumRequired is the number of environments in the current phase
+	// the release must be deployed to before the lifecycle moves on.
+	MinimumRequired int
+	Phases          []*PhasePlan
+}
+
+// PhasePlan describes the progress of a release through one lifecycle phase.
+type PhasePlan struct {
+	Name       string
+	Progress   releases.PhaseProgress
+	IsOptional bool
+
+	// EnvironmentIDs are the environments of the phase, both those deployed
+	// to automatically and those deployed to by hand.
+	EnvironmentIDs []string
+
+	// DeployedEnvironmentIDs are the environments of the phase the release
+	// has been deployed to successfully.
+	DeployedEnvironmentIDs []string
+
+	// MinimumEnvironmentsBeforePromotion is the number of environments the
+	// release must be deployed to before it can leave the phase; zero means
+	// all of them.
+	MinimumEnvironmentsBeforePromotion int
+
+	// Blocked is set when the release cannot yet be deployed to the
+	// environments of the phase, and BlockedReason says why.
+	Blocked       bool
+	BlockedReason string
+}
+
+// IsSatisfied reports whether the release can leave the phase: either the
+// phase is optional, or the release has been deployed to enough of its
+// environments.
+func (p *PhasePlan) IsSatisfied() bool {
+	return p.IsOptional || p.Progress == releases.PhaseProgressComplete || len(p.DeployedEnvironmentIDs) >= p.requiredEnvironments()
+}
+
+func (p *PhasePlan) requiredEnvironments() int {
+	if p.MinimumEnvironmentsBeforePromotion > 0 {
+		return min(p.MinimumEnvironmentsBeforePromotion, len(p.EnvironmentIDs))
+	}
+	return len(p.EnvironmentIDs)
+}
+
+// PlanPromotion reads the progression of a release through its lifecycle
+// and reports which environments it can be promoted to next, and which
+// phases are blocked and why.
+func PlanPromotion(client newclient.Client, spaceID string, release *releases.Release) (*PromotionPlan, error) {
+	return PlanPromotionWithContext(context.Background(), client, spaceID, release)
+}
+
+// PlanPromotionWithContext is like PlanPromotion, but uses ctx to control cancellation of the HTTP requests.
+func PlanPromotionWithContext(ctx context.Context, client newclient.Client, spaceID string, release *releases.Release) (*PromotionPlan, error) {
+	if release == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("release")
+	}
+
+	progression, err := releases.GetProgressionWithContext(ctx, client, spaceID, release.ID)
+	if err != nil {
+		return nil, err
+	}
+	return newPromotionPlan(release.ID, progression), nil
+}
+
+func newPromotionPlan(releaseID string, progression *releases.LifecycleProgression) *PromotionPlan {
+	plan := &PromotionPlan{
+		ReleaseID:          releaseID,
+		NextEnvironmentIDs: []string{},
+		MinimumRequired:    progression.NextDeploymentsMinimumRequired,
+		Phases:             []*PhasePlan{},
+	}
+
+	deployed := map[string]bool{}
+	var unsatisfied *PhasePlan
+	for _, phase := range progression.Phases {
+		if phase == nil {
+			continue
+		}
+		phasePlan := &PhasePlan{
+			Name:                               phase.Name,
+			Progress:                           phase.Progress,
+			IsOptional:                         phase.IsOptionalPhase,
+			EnvironmentIDs:                     mergeEnvironmentIDs(phase.AutomaticDeploymentTargets, phase.OptionalDeploymentTargets),
+			DeployedEnvironmentIDs:             []string{},
+			MinimumEnvironmentsBeforePromotion: phase.MinimumEnvironmentsBeforePromotion,
+			Blocked:                            phase.Blocked,
+		}
+		for _, deployment := range phase.Deployments {
+			if deployment == nil || deployment.Task == nil || deployment.Deployment == nil || tasks.TaskState(deployment.Task.State) != tasks.TaskStateSuccess {
+				continue
+			}
+			deployed[deployment.Deployment.EnvironmentID] = true
+			if !slices.Contains(phasePlan.DeployedEnvironmentIDs, deployment.Deployment.EnvironmentID) {
+				phasePlan.DeployedEnvironmentIDs = append(phasePlan.DeployedEnvironmentIDs, deployment.Deployment.EnvironmentID)
+			}
+		}
+
+		// a phase cannot be entered until every earlier phase which is not optional is satisfied
+		if unsatisfied != nil {
+			phasePlan.Blocked = true
+			phasePlan.BlockedReason = unsatisfied.unsatisfiedReason()
+		} else if phasePlan.Blocked {
+			phasePlan.BlockedReason = "the server reports that the phase is blocked"
+		}
+		if unsatisfied == nil && !phasePlan.IsSatisfied() {
+			unsatisfied = phasePlan
+		}
+
+		plan.Phases = append(plan.Phases, phasePlan)
+	}
+
+	for _, environmentID := range progression.NextDeployments {
+		if !deployed[environmentID] && !slices.Contains(plan.NextEnvironmentIDs, environmentID) {
+			plan.NextEnvironmentIDs = append(plan.NextEnvironmentIDs, environmentID)
+		}
+	}
+	return plan
+}
+
+func (p *PhasePlan) unsatisfiedReason() string {
+	remaining := p.requiredEnvironments() - len(p.DeployedEnvironmentIDs)
+	if p.MinimumEnvironmentsBeforePromotion > 0 {
+		return fmt.Sprintf("the release must be deployed to %d more environment(s) in phase '%s', which requires at least %d", remaining, p.Name, p.MinimumEnvironmentsBeforePromotion)
+	}
+	return fmt.Sprintf("the release must be deployed to %d more environment(s) in phase '%s', which requires all of its environments", remaining, p.Name)
+}
+
+func mergeEnvironmentIDs(automatic []string, optional []string) []string {
+	environmentIDs := []string{}
+	for _, environmentID := range slices.Concat(automatic, optional) {
+		if !slices.Contains(environmentIDs, environmentID) {
+			environmentIDs = append(environmentIDs, environmentID)
+		}
+	}
+	return environmentIDs
+}
+
+// PromoteOptions controls how PromoteRelease deploys a release.
+type PromoteOptions struct {
+	// EnvironmentIDs limits the promotion to some of the environments the
+	// release can be promoted to next. If empty, the release is deployed to
+	// all of them.
+	EnvironmentIDs []string
+
+	// Wait controls how the deployments are polled while waiting for them to
+	// complete.
+	Wait *tasks.WaitOptions
+}
+
+// PromotionResult is the outcome of promoting a release.
+type PromotionResult struct {
+	Plan        *PromotionPlan
+	Deployments []*DeploymentServerTask
+
+	// Tasks are the final states of the deployment tasks, in the same order
+	// as Deployments.
+	Tasks []*tasks.Task
+}
+
+// PromoteRelease deploys a release to the environments it can be promoted to
+// next and waits for the deployments to complete. If there is no environment
+// to promote the release to, ErrNoEligibleEnvironments is returned. If any
+// deployment does not succeed, the result is returned along with a
+// *tasks.TaskError.
+//
+// The release is deployed as untenanted; tenanted projects should be
+// deployed with CreateDeploymentTenantedV1.
+func PromoteRelease(client newclient.Client, spaceID string, release *releases.Release, options *PromoteOptions) (*PromotionResult, error) {
+	return PromoteReleaseWithContext(context.Background(), client, spaceID, release, options)
+}
+
+// PromoteReleaseWithContext is like PromoteRelease, but uses ctx to control cancellation of the HTTP requests.
+func PromoteReleaseWithContext(ctx context.Context, client newclient.Client, spaceID string, release *releases.Release, options *PromoteOptions) (*PromotionResult, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if release == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("release")
+	}
+	if options == nil {
+		options = &PromoteOptions{}
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := PlanPromotionWithContext(ctx, client, spaceID, release)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentIDs := plan.NextEnvironmentIDs
+	if len(options.EnvironmentIDs) > 0 {
+		for _, environmentID := range options.EnvironmentIDs {
+			if !slices.Contains(plan.NextEnvironmentIDs, environmentID) {
+				return nil, fmt.Errorf("%w: %s", ErrNoEligibleEnvironments, environmentID)
+			}
+		}
+		environmentIDs = options.EnvironmentIDs
+	}
+	if len(environmentIDs) == 0 {
+		return nil, ErrNoEligibleEnvironments
+	}
+
+	// the server looks environments up by name or ID
+	command := NewCreateDeploymentUntenantedCommandV1(spaceID, release.ProjectID)
+	command.ReleaseVersion = release.Version
+	command.EnvironmentNames = environmentIDs
+	response, err := CreateDeploymentUntenantedV1WithContext(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PromotionResult{Plan: plan, Deployments: response.DeploymentServerTasks}
+	taskIDs := make([]string, 0, len(response.DeploymentServerTasks))
+	for _, deploymentServerTask := range response.DeploymentServerTasks {
+		taskIDs = append(taskIDs, deploymentServerTask.ServerTaskID)
+	}
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	result.Tasks, err = tasks.WaitForTasksWithContext(ctx, client, spaceID, taskIDs, options.Wait)
+	return result, err
+}
@@ -0,0 +1,106 @@
+package deployments_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+// releaseProgression has a release deployed to one of the two environments
+// of a phase which requires both, followed by an optional phase and a
+// production phase.
+const releaseProgression = `{
+	"Phases": [
+		{"Name": "Dev", "Progress": "Complete", "AutomaticDeploymentTargets": ["Environments-1"], "OptionalDeploymentTargets": [],
+			"Deployments": [{"Task": {"Id": "ServerTasks-1", "State": "Success"}, "Deployment": {"Id": "Deployments-1", "EnvironmentId": "Environments-1"}}]},
+		{"Name": "Test", "Progress": "Current", "AutomaticDeploymentTargets": [], "OptionalDeploymentTargets": ["Environments-2", "Environments-3"],
+			"Deployments": [
+				{"Task": {"Id": "ServerTasks-2", "State": "Success"}, "Deployment": {"Id": "Deployments-2", "EnvironmentId": "Environments-2"}},
+				{"Task": {"Id": "ServerTasks-3", "State": "Failed"}, "Deployment": {"Id": "Deployments-3", "EnvironmentId": "Environments-3"}}
+			]},
+		{"Name": "Hotfix", "Progress": "Pending", "IsOptionalPhase": true, "OptionalDeploymentTargets": ["Environments-4"], "Deployments": []},
+		{"Name": "Production", "Progress": "Pending", "Blocked": true, "MinimumEnvironmentsBeforePromotion": 1, "OptionalDeploymentTargets": ["Environments-5"], "Deployments": []}
+	],
+	"NextDeployments": ["Environments-2", "Environments-3"],
+	"NextDeploymentsMinimumRequired": 1
+}`
+
+func newPromotionTestRelease() *releases.Release {
+	release := releases.NewRelease("Channels-1", "Projects-1", "1.2.3")
+	release.ID = "Releases-1"
+	return release
+}
+
+func TestPlanPromotion(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/releases/Releases-1/progression", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(releaseProgression))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	plan, err := deployments.PlanPromotion(client, "Spaces-1", newPromotionTestRelease())
+	require.NoError(t, err)
+
+	// Environments-2 already has a successful deployment of the release
+	require.Equal(t, []string{"Environments-3"}, plan.NextEnvironmentIDs)
+	require.Equal(t, 1, plan.MinimumRequired)
+	require.Len(t, plan.Phases, 4)
+
+	dev, test, hotfix, production := plan.Phases[0], plan.Phases[1], plan.Phases[2], plan.Phases[3]
+	require.True(t, dev.IsSatisfied())
+	require.False(t, dev.Blocked)
+
+	require.Equal(t, []string{"Environments-2", "Environments-3"}, test.EnvironmentIDs)
+	require.Equal(t, []string{"Environments-2"}, test.DeployedEnvironmentIDs)
+	require.False(t, test.IsSatisfied())
+	require.False(t, test.Blocked)
+
+	require.True(t, hotfix.IsOptional)
+	require.True(t, hotfix.IsSatisfied())
+	require.True(t, hotfix.Blocked)
+	require.Contains(t, hotfix.BlockedReason, "1 more environment(s) in phase 'Test'")
+
+	require.True(t, production.Blocked)
+	require.Contains(t, production.BlockedReason, "phase 'Test'")
+}
+
+func TestPromoteRelease(t *testing.T) {
+	var command map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/releases/Releases-1/progression", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(releaseProgression))
+	})
+	mux.HandleFunc("POST /api/Spaces-1/deployments/create/untenanted/v1", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&command))
+		_, _ = w.Write([]byte(`{"DeploymentServerTasks": [{"DeploymentId": "Deployments-4", "ServerTaskId": "ServerTasks-4"}]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "ServerTasks-4", r.URL.Query().Get("ids"))
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-4", "State": "Success"}]}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	result, err := deployments.PromoteRelease(client, "Spaces-1", newPromotionTestRelease(), &deployments.PromoteOptions{
+		Wait: &tasks.WaitOptions{PollInterval: time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", command["releaseVersion"])
+	require.Equal(t, "Projects-1", command["projectName"])
+	require.Equal(t, []any{"Environments-3"}, command["environmentNames"])
+	require.Len(t, result.Deployments, 1)
+	require.Len(t, result.Tasks, 1)
+	require.Equal(t, string(tasks.TaskStateSuccess), result.Tasks[0].State)
+
+	_, err = deployments.PromoteRelease(client, "Spaces-1", newPromotionTestRelease(), &deployments.PromoteOptions{
+		EnvironmentIDs: []string{"Environments-5"},
+	})
+	require.True(t, errors.Is(err, deployments.ErrNoEligibleEnvironments))
+}
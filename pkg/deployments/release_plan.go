@@ -0,0 +1,327 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/releases"
+)
+
+// ReleasePlan is the set of package versions a release of a project in a
+// channel would use. It is produced by PlanRelease, and can be inspected and
+// adjusted before it is turned into a command for releases.CreateReleaseV1.
+type ReleasePlan struct {
+	SpaceID   string
+	ProjectID string
+	ChannelID string
+
+	// GitRef is the branch or tag the deployment process was read from, for
+	// projects which keep their process in version control.
+	GitRef   string
+	Packages []*PlannedPackage
+}
+
+// PlannedPackage is a package referenced by an action in the deployment
+// process, and the version of it chosen for the release.
+type PlannedPackage struct {
+	ActionName string
+
+	// PackageReferenceName is empty for an action's primary package.
+	PackageReferenceName string
+	PackageID            string
+	FeedID               string
+
+	// VersionRange and PreReleaseTag are copied from the channel rule which
+	// applies to the package, if there is one.
+	VersionRange  string
+	PreReleaseTag string
+
+	// Version is the version the release will use. It is empty if no version
+	// could be resolved, in which case Reason says why.
+	Version string
+	Reason  string
+}
+
+// IsResolved reports whether a version has been chosen for the package.
+func (p *PlannedPackage) IsResolved() bool {
+	return p.Version != ""
+}
+
+// Unresolved returns the packages for which no version has been chosen.
+func (p *ReleasePlan) Unresolved() []*PlannedPackage {
+	var unresolved []*PlannedPackage
+	for _, plannedPackage := range p.Packages {
+		if !plannedPackage.IsResolved() {
+			unresolved = append(unresolved, plannedPackage)
+		}
+	}
+	return unresolved
+}
+
+// SetVersion overrides the version chosen for the package an action
+// references by packageReferenceName, which is empty for the action's primary
+// package.
+func (p *ReleasePlan) SetVersion(actionName string, packageReferenceName string, version string) error {
+	if internal.IsEmpty(version) {
+		return internal.CreateRequiredParameterIsEmptyError("version")
+	}
+	for _, plannedPackage := range p.Packages {
+		if plannedPackage.ActionName == actionName && plannedPackage.PackageReferenceName == packageReferenceName {
+			plannedPackage.Version = version
+			plannedPackage.Reason = ""
+			return nil
+		}
+	}
+	return fmt.Errorf("the release plan has no package referenced by action '%s' as '%s'", actionName, packageReferenceName)
+}
+
+// NewCreateReleaseCommandV1 returns a command which creates a release with
+// the versions chosen by the plan. It returns an error if any package is
+// unresolved.
+func (p *ReleasePlan) NewCreateReleaseCommandV1(releaseVersion string) (*releases.CreateReleaseCommandV1, error) {
+	command := releases.NewCreateReleaseCommandV1(p.SpaceID, p.ProjectID)
+	command.ChannelIDOrName = p.ChannelID
+	command.GitRef = p.GitRef
+	command.ReleaseVersion = releaseVersion
+
+	for _, plannedPackage := range p.Packages {
+		if !plannedPackage.IsResolved() {
+			return nil, fmt.Errorf("no version is chosen for the package referenced by action '%s': %s", plannedPackage.ActionName, plannedPackage.Reason)
+		}
+		// the server accepts "<action>:<version>" for an action's primary package,
+		// and "<action>:<reference>:<version>" for its other packages
+		parts := []string{plannedPackage.ActionName}
+		if plannedPackage.PackageReferenceName != "" {
+			parts = append(parts, plannedPackage.PackageReferenceName)
+		}
+		command.Packages = append(command.Packages, strings.Join(append(parts, plannedPackage.Version), ":"))
+	}
+	return command, nil
+}
+
+// PlanRelease reads the deployment process of a project and the rules of one
+// of its channels, and chooses the latest version of every package referenced
+// by the process which satisfies the channel's rules. Packages which cannot be
+// resolved, such as those whose feed or package ID is bound to a variable,
+// are left unresolved in the plan rather than reported as errors.
+//
+// The channel's rules are sent to each package's feed to narrow the search,
+// and the versions it returns are tested against them locally. A rule which
+// cannot be evaluated locally, such as a pre-release tag using a lookaround,
+// leaves its packages unresolved.
+//
+// For projects which keep their process in version control, the process is
+// read from gitRef, or the project's default branch if gitRef is empty.
+func PlanRelease(client newclient.Client, spaceID string, project *projects.Project, channel *channels.Channel, gitRef string) (*ReleasePlan, error) {
+	return PlanReleaseWithContext(context.Background(), client, spaceID, project, channel, gitRef)
+}
+
+// PlanReleaseWithContext is like PlanRelease, but uses ctx to control cancellation of the HTTP requests.
+func PlanReleaseWithContext(ctx context.Context, client newclient.Client, spaceID string, project *projects.Project, channel *channels.Channel, gitRef string) (*ReleasePlan, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if project == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("project")
+	}
+	if channel == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("channel")
+	}
+	if channel.ProjectID != project.ID {
+		return nil, internal.CreateInvalidParameterError("PlanRelease", "channel")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentProcess, err := GetDeploymentProcessByGitRefWithContext(ctx, client, spaceID, project, gitRef)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &ReleasePlan{
+		SpaceID:   spaceID,
+		ProjectID: project.ID,
+		ChannelID: channel.ID,
+		GitRef:    deploymentProcess.Branch,
+		Packages:  []*PlannedPackage{},
+	}
+
+	// several actions often deploy the same package, so each search is made once
+	type search struct{ feedID, packageID, versionRange, preReleaseTag string }
+	versions := map[search]string{}
+	feedTypes := map[string]string{}
+
+	for _, step := range deploymentProcess.Steps {
+		if step == nil {
+			continue
+		}
+		for _, action := range step.Actions {
+			if action == nil || action.IsDisabled || (len(action.Channels) > 0 && !slices.Contains(action.Channels, channel.ID)) {
+				continue
+			}
+			for _, packageReference := range action.Packages {
+				if packageReference == nil {
+					continue
+				}
+				plannedPackage := &PlannedPackage{
+					ActionName:           action.Name,
+					PackageReferenceName: packageReference.Name,
+					PackageID:            packageReference.PackageID,
+					FeedID:               packageReference.FeedID,
+				}
+				plan.Packages = append(plan.Packages, plannedPackage)
+
+				rule := findChannelRule(channel, action.Name, packageReference)
+				if rule != nil {
+					plannedPackage.VersionRange = rule.VersionRange
+					plannedPackage.PreReleaseTag = rule.Tag
+				}
+
+				if isVariableExpression(packageReference.FeedID) || isVariableExpression(packageReference.PackageID) {
+					plannedPackage.Reason = "the feed or package ID is bound to a variable, so is only known when the release is deployed"
+					continue
+				}
+				if packageReference.FeedID == "" || packageReference.PackageID == "" {
+					plannedPackage.Reason = "the package reference has no feed or package ID"
+					continue
+				}
+
+				feedType, found := feedTypes[packageReference.FeedID]
+				if !found {
+					feed, err := feeds.GetByIDWithContext(ctx, client, spaceID, packageReference.FeedID)
+					if err != nil {
+						return nil, err
+					}
+					feedType = string(feed.GetFeedType())
+					feedTypes[packageReference.FeedID] = feedType
+				}
+				if rule != nil {
+					if err := rule.ValidateVersionRule(feedType); err != nil {
+						plannedPackage.Reason = fmt.Sprintf("the channel's rule cannot be evaluated: %v", err)
+						continue
+					}
+				}
+
+				key := search{packageReference.FeedID, packageReference.PackageID, plannedPackage.VersionRange, plannedPackage.PreReleaseTag}
+				version, searched := versions[key]
+				if !searched {
+					if version, err = findLatestVersion(ctx, client, spaceID, feedType, plannedPackage); err != nil {
+						return nil, err
+					}
+					versions[key] = version
+				}
+
+				plannedPackage.Version = version
+				if version == "" {
+					plannedPackage.Reason = "the feed has no version of the package which satisfies the channel's rules"
+				}
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// findChannelRule returns the rule of channel which applies to a package
+// referenced by an action, or nil if there is none.
+func findChannelRule(channel *channels.Channel, actionName string, packageReference *packages.PackageReference) *channels.ChannelRule {
+	for i, rule := range channel.Rules {
+		for _, actionPackage := range rule.ActionPackages {
+			if actionPackage.DeploymentAction == actionName && actionPackage.PackageReference == packageReference.Name {
+				return &channel.Rules[i]
+			}
+		}
+	}
+	return nil
+}
+
+// packageVersionsPageSize is the number of versions read from a feed in each
+// request made by findLatestVersion.
+const packageVersionsPageSize = 100
+
+// findLatestVersion returns the highest version of a package satisfying the
+// rule recorded in plannedPackage, or an empty string if there is none.
+//
+// The rule is sent to the feed to narrow the search, and the versions it
+// returns are tested against the rule locally with
+// channels.EvaluateVersionRule, so a feed which interprets the rule
+// differently cannot choose a version the rule excludes. If the feed rejects
+// the rule, every version of the package is read and tested locally instead.
+func findLatestVersion(ctx context.Context, client newclient.Client, spaceID string, feedType string, plannedPackage *PlannedPackage) (string, error) {
+	query := feeds.SearchPackageVersionsQuery{
+		FeedID:            plannedPackage.FeedID,
+		PackageID:         plannedPackage.PackageID,
+		IncludePreRelease: true,
+		PreReleaseTag:     plannedPackage.PreReleaseTag,
+		VersionRange:      plannedPackage.VersionRange,
+		Take:              packageVersionsPageSize,
+	}
+
+	version, err := searchLatestVersion(ctx, client, spaceID, feedType, plannedPackage, query, true)
+	if errors.Is(err, core.ErrValidation) && (query.PreReleaseTag != "" || query.VersionRange != "") {
+		query.PreReleaseTag = ""
+		query.VersionRange = ""
+		return searchLatestVersion(ctx, client, spaceID, feedType, plannedPackage, query, false)
+	}
+	return version, err
+}
+
+// searchLatestVersion pages through the versions of a package returned by
+// query, and returns the highest which satisfies the rule recorded in
+// plannedPackage. Feeds return versions highest first, so if stopAtFirstMatch
+// is set, no more pages are read once one has a satisfying version.
+func searchLatestVersion(ctx context.Context, client newclient.Client, spaceID string, feedType string, plannedPackage *PlannedPackage, query feeds.SearchPackageVersionsQuery, stopAtFirstMatch bool) (string, error) {
+	format := channels.VersionFormatForFeedType(feedType)
+
+	latest := ""
+	for {
+		packageVersions, err := feeds.SearchPackageVersionsByQueryWithContext(ctx, client, spaceID, query)
+		if err != nil {
+			return "", err
+		}
+
+		for _, packageVersion := range packageVersions.Items {
+			result := channels.EvaluateVersionRule(channels.VersionRuleTestQuery{
+				FeedType:      feedType,
+				PreReleaseTag: plannedPackage.PreReleaseTag,
+				Version:       packageVersion.Version,
+				VersionRange:  plannedPackage.VersionRange,
+			})
+			if !result.IsSatisfied() {
+				continue
+			}
+			if latest == "" {
+				latest = packageVersion.Version
+				continue
+			}
+			comparison, err := channels.CompareVersions(format, packageVersion.Version, latest)
+			if err != nil {
+				return "", err
+			}
+			if comparison > 0 {
+				latest = packageVersion.Version
+			}
+		}
+
+		if (stopAtFirstMatch && latest != "") || len(packageVersions.Items) == 0 || packageVersions.Links.PageNext == "" {
+			return latest, nil
+		}
+		query.Skip += len(packageVersions.Items)
+	}
+}
+
+func isVariableExpression(value string) bool {
+	return strings.Contains(value, "#{")
+}
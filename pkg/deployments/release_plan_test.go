@@ -0,0 +1,197 @@
+package deployments_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+const releasePlanProcess = `{
+	"Id": "deploymentprocess-Projects-1",
+	"ProjectId": "Projects-1",
+	"Steps": [{
+		"Name": "Deploy",
+		"Actions": [
+			{"Name": "Web", "Packages": [
+				{"Name": "", "PackageId": "Web", "FeedId": "Feeds-1"},
+				{"Name": "Config", "PackageId": "Config", "FeedId": "Feeds-1"}
+			]},
+			{"Name": "Api", "Packages": [{"Name": "", "PackageId": "Web", "FeedId": "Feeds-1"}]},
+			{"Name": "Dynamic", "Packages": [{"Name": "", "PackageId": "#{PackageId}", "FeedId": "Feeds-1"}]},
+			{"Name": "Disabled", "IsDisabled": true, "Packages": [{"Name": "", "PackageId": "Web", "FeedId": "Feeds-1"}]},
+			{"Name": "OtherChannel", "Channels": ["Channels-2"], "Packages": [{"Name": "", "PackageId": "Web", "FeedId": "Feeds-1"}]}
+		]
+	}]
+}`
+
+// newReleasePlanHandler serves the deployment process of releasePlanProcess
+// and the versions of its packages, recording each search in searches. The
+// feed returns the versions in no particular order, without filtering them,
+// or rejects searches with a rule if rejectRules is set.
+func newReleasePlanHandler(t *testing.T, searches *[]url.Values, rejectRules bool) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/deploymentprocesses/deploymentprocess-Projects-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(releasePlanProcess))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/feeds/Feeds-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "Feeds-1", "Name": "Packages", "FeedType": "BuiltIn"}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/feeds/Feeds-1/packages/versions", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		*searches = append(*searches, query)
+		if rejectRules && (query.Has("versionRange") || query.Has("preReleaseTag")) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"ErrorMessage": "The version range is not valid for this feed"}`))
+			return
+		}
+
+		var versions []string
+		switch query.Get("packageId") {
+		case "Web":
+			versions = []string{"1.10.0", "1.4.0-beta", "1.12.0-beta", "2.1.0-beta", "1.9.0-beta", "1.13.0-alpha", "not-a-version"}
+		case "Config":
+			// the feed has no versions of the package
+		}
+		skip, _ := strconv.Atoi(query.Get("skip"))
+		take, _ := strconv.Atoi(query.Get("take"))
+		take = min(take, 4)
+
+		response := map[string]any{"Items": []*packages.PackageVersion{}}
+		for _, version := range versions[min(skip, len(versions)):min(skip+take, len(versions))] {
+			response["Items"] = append(response["Items"].([]*packages.PackageVersion), &packages.PackageVersion{PackageID: query.Get("packageId"), Version: version})
+		}
+		if skip+take < len(versions) {
+			response["Links"] = map[string]string{"Page.Next": "/next"}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	})
+	return mux
+}
+
+// newReleasePlanChannel returns a channel of the project of
+// releasePlanProcess whose rule applies to the Web package.
+func newReleasePlanChannel() *channels.Channel {
+	channel := channels.NewChannel("Beta", "Projects-1")
+	channel.ID = "Channels-1"
+	channel.Rules = []channels.ChannelRule{{
+		ActionPackages: []packages.DeploymentActionPackage{{DeploymentAction: "Web", PackageReference: ""}, {DeploymentAction: "Api"}},
+		VersionRange:   "[1.0,2.0)",
+		Tag:            "^beta",
+	}}
+	return channel
+}
+
+func newReleasePlanProject() *projects.Project {
+	project := projects.NewProject("Shop", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	project.DeploymentProcessID = "deploymentprocess-Projects-1"
+	return project
+}
+
+func TestPlanRelease(t *testing.T) {
+	var searches []url.Values
+	client := testutil.NewTestClient(t, newReleasePlanHandler(t, &searches, false))
+
+	plan, err := deployments.PlanRelease(client, "Spaces-1", newReleasePlanProject(), newReleasePlanChannel(), "")
+	require.NoError(t, err)
+	require.Equal(t, "Projects-1", plan.ProjectID)
+	require.Equal(t, "Channels-1", plan.ChannelID)
+
+	// disabled actions and those scoped to other channels are not planned
+	require.Len(t, plan.Packages, 4)
+
+	web := plan.Packages[0]
+	require.Equal(t, "Web", web.ActionName)
+	require.Equal(t, "[1.0,2.0)", web.VersionRange)
+	require.Equal(t, "^beta", web.PreReleaseTag)
+	require.Equal(t, "1.12.0-beta", web.Version)
+	require.Equal(t, "1.12.0-beta", plan.Packages[2].Version)
+
+	config := plan.Packages[1]
+	require.Equal(t, "Config", config.PackageReferenceName)
+	require.Empty(t, config.VersionRange)
+	require.False(t, config.IsResolved())
+	require.NotEmpty(t, config.Reason)
+
+	require.False(t, plan.Packages[3].IsResolved())
+	require.Len(t, plan.Unresolved(), 2)
+
+	// the Web package is searched once for both the actions which deploy it,
+	// with the rule, and the first page has a version which satisfies it
+	require.Len(t, searches, 2)
+	require.Equal(t, "[1.0,2.0)", searches[0].Get("versionRange"))
+	require.Equal(t, "^beta", searches[0].Get("preReleaseTag"))
+	require.Equal(t, "true", searches[0].Get("includePreRelease"))
+	require.Equal(t, "Config", searches[1].Get("packageId"))
+	require.False(t, searches[1].Has("versionRange"))
+
+	_, err = plan.NewCreateReleaseCommandV1("1.0.0")
+	require.Error(t, err)
+
+	require.NoError(t, plan.SetVersion("Web", "Config", "3.0.0"))
+	require.NoError(t, plan.SetVersion("Dynamic", "", "1.0.1"))
+	require.Error(t, plan.SetVersion("Missing", "", "1.0.0"))
+
+	command, err := plan.NewCreateReleaseCommandV1("1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "Spaces-1", command.SpaceID)
+	require.Equal(t, "Projects-1", command.ProjectIDOrName)
+	require.Equal(t, "Channels-1", command.ChannelIDOrName)
+	require.Equal(t, "1.0.0", command.ReleaseVersion)
+	require.Equal(t, []string{"Web:1.12.0-beta", "Web:Config:3.0.0", "Api:1.12.0-beta", "Dynamic:1.0.1"}, command.Packages)
+}
+
+func TestPlanReleaseReadsEveryVersionWhenFeedRejectsRule(t *testing.T) {
+	var searches []url.Values
+	client := testutil.NewTestClient(t, newReleasePlanHandler(t, &searches, true))
+
+	plan, err := deployments.PlanRelease(client, "Spaces-1", newReleasePlanProject(), newReleasePlanChannel(), "")
+	require.NoError(t, err)
+	require.Equal(t, "1.12.0-beta", plan.Packages[0].Version)
+
+	// the rejected search is repeated without the rule, reading every page
+	require.Len(t, searches, 4)
+	require.Equal(t, "[1.0,2.0)", searches[0].Get("versionRange"))
+	require.False(t, searches[1].Has("versionRange"))
+	require.False(t, searches[1].Has("preReleaseTag"))
+	require.Equal(t, "4", searches[2].Get("skip"))
+	require.Equal(t, "Config", searches[3].Get("packageId"))
+}
+
+func TestPlanReleaseLeavesPackagesOfUnevaluableRulesUnresolved(t *testing.T) {
+	var searches []url.Values
+	client := testutil.NewTestClient(t, newReleasePlanHandler(t, &searches, false))
+
+	channel := newReleasePlanChannel()
+	channel.Rules[0].VersionRange = ""
+	channel.Rules[0].Tag = "^(?!alpha)"
+
+	plan, err := deployments.PlanRelease(client, "Spaces-1", newReleasePlanProject(), channel, "")
+	require.NoError(t, err)
+	require.False(t, plan.Packages[0].IsResolved())
+	require.Contains(t, plan.Packages[0].Reason, "cannot be evaluated")
+
+	// only the Config package, which has no rule, is searched
+	require.Len(t, searches, 1)
+	require.Equal(t, "Config", searches[0].Get("packageId"))
+}
+
+func TestPlanReleaseRejectsChannelOfAnotherProject(t *testing.T) {
+	client := testutil.NewTestClient(t, http.NotFoundHandler())
+
+	project := projects.NewProject("Shop", "Lifecycles-1", "ProjectGroups-1")
+	project.ID = "Projects-1"
+	channel := channels.NewChannel("Beta", "Projects-2")
+
+	_, err := deployments.PlanRelease(client, "Spaces-1", project, channel, "")
+	require.Error(t, err)
+}
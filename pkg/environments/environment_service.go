@@ -1,6 +1,9 @@
 package environments
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -178,31 +181,73 @@ const template = "/api/{spaceId}/environments{/id}{?name,skip,ids,take,partialNa
 // its input query parameter. If an error occurs, an empty collection is
 // returned along with the associated error.
 func Get(client newclient.Client, spaceID string, environmentsQuery EnvironmentsQuery) (*resources.Resources[*Environment], error) {
-	return newclient.GetByQuery[Environment](client, template, spaceID, environmentsQuery)
+	return GetWithContext(context.Background(), client, spaceID, environmentsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, environmentsQuery EnvironmentsQuery) (*resources.Resources[*Environment], error) {
+	return newclient.GetByQueryWithContext[Environment](ctx, client, template, spaceID, environmentsQuery)
+}
+
+// Iterate returns an iterator over the environments matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query EnvironmentsQuery) iter.Seq2[*Environment, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query EnvironmentsQuery) iter.Seq2[*Environment, error] {
+	return newclient.IterateByQueryWithContext[Environment](ctx, client, template, spaceID, query)
 }
 
 // Add creates a new environment.
 func Add(client newclient.Client, environment *Environment) (*Environment, error) {
-	return newclient.Add[Environment](client, template, environment.SpaceID, environment)
+	return AddWithContext(context.Background(), client, environment)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, environment *Environment) (*Environment, error) {
+	return newclient.AddWithContext[Environment](ctx, client, template, environment.SpaceID, environment)
 }
 
 // DeleteById deletes the environment based on the ID provided as input.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetByID returns the environment that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Environment, error) {
-	return newclient.GetByID[Environment](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Environment, error) {
+	return newclient.GetByIDWithContext[Environment](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies an environment based on the one provided as input.
 func Update(client newclient.Client, environment *Environment) (*Environment, error) {
-	return newclient.Update[Environment](client, template, environment.SpaceID, environment.ID, environment)
+	return UpdateWithContext(context.Background(), client, environment)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, environment *Environment) (*Environment, error) {
+	return newclient.UpdateWithContext[Environment](ctx, client, template, environment.SpaceID, environment.ID, environment)
 }
 
 // GetAll returns all environments. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*Environment, error) {
-	return newclient.GetAll[Environment](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*Environment, error) {
+	return newclient.GetAllWithContext[Environment](ctx, client, template, spaceID)
 }
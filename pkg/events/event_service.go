@@ -1,9 +1,12 @@
 package events
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
@@ -99,3 +102,19 @@ func (s EventService) GetGroups(query EventGroupsQuery) (*[]EventGroup, error) {
 
 	return resp.(*[]EventGroup), nil
 }
+
+// --- new ---
+
+const template = "/api/{spaceId}/events{/id}{?skip,regarding,regardingAny,user,users,projects,projectGroups,environments,eventGroups,eventCategories,eventAgents,tags,tenants,from,to,internal,fromAutoId,toAutoId,documentTypes,asCsv,take,ids,spaces,includeSystem,excludeDifference}"
+
+// Iterate returns an iterator over the events matching the criteria defined
+// by its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query EventsQuery) iter.Seq2[*Event, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query EventsQuery) iter.Seq2[*Event, error] {
+	return newclient.IterateByQueryWithContext[Event](ctx, client, template, spaceID, query)
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"net/http"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterate(t *testing.T) {
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/events", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("skip") == "" {
+			require.Equal(t, "2", r.URL.Query().Get("take"))
+			require.Equal(t, "Projects-1", r.URL.Query().Get("projects"))
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "Events-1"}, {"Id": "Events-2"}], "Links": {"Page.Next": "/api/Spaces-1/events?skip=2&take=2&projects=Projects-1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Events-3"}], "Links": {}}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+	query := EventsQuery{Projects: []string{"Projects-1"}, Take: 2}
+
+	ids := []string{}
+	for event, err := range Iterate(client, "", query) {
+		require.NoError(t, err)
+		ids = append(ids, event.GetID())
+	}
+	require.Equal(t, []string{"Events-1", "Events-2", "Events-3"}, ids)
+	require.Equal(t, 2, requests)
+
+	// breaking out early stops the next page from being requested
+	requests = 0
+	for range Iterate(client, "", query) {
+		break
+	}
+	require.Equal(t, 1, requests)
+}
@@ -1,6 +1,9 @@
 package feeds
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -212,6 +215,11 @@ const template = "/api/{spaceId}/feeds{/id}{?skip,take,ids,partialName,feedType,
 
 // note, the FeedID here has to be a real ID. You can't supply "feeds-builtin", you need to lookup the ID as "Feeds-101" etc, and use that instead
 func SearchPackageVersions(client newclient.Client, spaceID string, feedID string, packageID string, filter string, limit int) (*resources.Resources[*packages.PackageVersion], error) {
+	return SearchPackageVersionsWithContext(context.Background(), client, spaceID, feedID, packageID, filter, limit)
+}
+
+// SearchPackageVersionsWithContext is like SearchPackageVersions, but uses ctx to control cancellation of the HTTP requests.
+func SearchPackageVersionsWithContext(ctx context.Context, client newclient.Client, spaceID string, feedID string, packageID string, filter string, limit int) (*resources.Resources[*packages.PackageVersion], error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -236,12 +244,66 @@ func SearchPackageVersions(client newclient.Client, spaceID string, feedID strin
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[resources.Resources[*packages.PackageVersion]](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[resources.Resources[*packages.PackageVersion]](ctx, client.HttpSession(), expandedUri)
+}
+
+// SearchPackageVersionsByQuery is like SearchPackageVersions, but also lets the feed filter the versions it returns
+// by version range and pre-release tag, as it does when applying channel rules. The feed and package are taken
+// from query.FeedID and query.PackageID.
+func SearchPackageVersionsByQuery(client newclient.Client, spaceID string, query SearchPackageVersionsQuery) (*resources.Resources[*packages.PackageVersion], error) {
+	return SearchPackageVersionsByQueryWithContext(context.Background(), client, spaceID, query)
+}
+
+// SearchPackageVersionsByQueryWithContext is like SearchPackageVersionsByQuery, but uses ctx to control cancellation of the HTTP requests.
+func SearchPackageVersionsByQueryWithContext(ctx context.Context, client newclient.Client, spaceID string, query SearchPackageVersionsQuery) (*resources.Resources[*packages.PackageVersion], error) {
+	if spaceID == "" {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
+	}
+	if query.FeedID == "" {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("query.FeedID")
+	}
+	if query.PackageID == "" {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("query.PackageID")
+	}
+
+	// the query's uri tags predate the route template, which names the feed "feedId" rather than "id"
+	templateParams := map[string]any{"spaceId": spaceID, "feedId": query.FeedID, "packageId": query.PackageID}
+	if query.Filter != "" {
+		templateParams["filter"] = query.Filter
+	}
+	if query.IncludePreRelease {
+		templateParams["includePreRelease"] = true
+	}
+	if query.IncludeReleaseNotes {
+		templateParams["includeReleaseNotes"] = true
+	}
+	if query.PreReleaseTag != "" {
+		templateParams["preReleaseTag"] = query.PreReleaseTag
+	}
+	if query.Skip > 0 {
+		templateParams["skip"] = query.Skip
+	}
+	if query.Take > 0 {
+		templateParams["take"] = query.Take
+	}
+	if query.VersionRange != "" {
+		templateParams["versionRange"] = query.VersionRange
+	}
+	expandedUri, err := client.URITemplateCache().Expand(uritemplates.FeedSearchPackageVersions, templateParams)
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[resources.Resources[*packages.PackageVersion]](ctx, client.HttpSession(), expandedUri)
 }
 
 // Add creates a new feed.
 func Add(client newclient.Client, feed IFeed) (IFeed, error) {
-	res, err := newclient.Add[FeedResource](client, template, feed.GetSpaceID(), feed)
+	return AddWithContext(context.Background(), client, feed)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, feed IFeed) (IFeed, error) {
+	res, err := newclient.AddWithContext[FeedResource](ctx, client, template, feed.GetSpaceID(), feed)
 	if err != nil {
 		return nil, err
 	}
@@ -251,20 +313,53 @@ func Add(client newclient.Client, feed IFeed) (IFeed, error) {
 // Get returns a collection of feeds based on the criteria defined by its
 // input query parameter.
 func Get(client newclient.Client, spaceID string, feedsQuery FeedsQuery) (*Feeds, error) {
+	return GetWithContext(context.Background(), client, spaceID, feedsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, feedsQuery FeedsQuery) (*Feeds, error) {
 	// TODO this method is wired for /api/Spaces-1/feeds?ids=feeds-builtin
 	// but the server also supports a simpler single-value at /api/Spaces-1/feeds/feeds-builtin
 	// we should support that too.
-	res, err := newclient.GetByQuery[FeedResource](client, template, spaceID, feedsQuery)
+	res, err := newclient.GetByQueryWithContext[FeedResource](ctx, client, template, spaceID, feedsQuery)
 	if err != nil {
 		return nil, err
 	}
 	return ToFeeds(res), nil
 }
 
+// Iterate returns an iterator over the feeds matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query FeedsQuery) iter.Seq2[IFeed, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query FeedsQuery) iter.Seq2[IFeed, error] {
+	return func(yield func(IFeed, error) bool) {
+		for res, err := range newclient.IterateByQueryWithContext[FeedResource](ctx, client, template, spaceID, query) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			feed, err := ToFeed(res)
+			if !yield(feed, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // GetByID returns the feed that matches the input ID. If one cannot be found,
 // it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (IFeed, error) {
-	res, err := newclient.GetByID[FeedResource](client, template, spaceID, id)
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (IFeed, error) {
+	res, err := newclient.GetByIDWithContext[FeedResource](ctx, client, template, spaceID, id)
 	if err != nil {
 		return nil, err
 	}
@@ -273,12 +368,17 @@ func GetByID(client newclient.Client, spaceID string, id string) (IFeed, error)
 
 // Update modifies a feed based on the one provided as input.
 func Update(client newclient.Client, feed IFeed) (IFeed, error) {
+	return UpdateWithContext(context.Background(), client, feed)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, feed IFeed) (IFeed, error) {
 	feedResource, err := ToFeedResource(feed)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := newclient.Update[FeedResource](client, template, feed.GetSpaceID(), feedResource.ID, feedResource)
+	res, err := newclient.UpdateWithContext[FeedResource](ctx, client, template, feed.GetSpaceID(), feedResource.ID, feedResource)
 	if err != nil {
 		return nil, err
 	}
@@ -288,11 +388,21 @@ func Update(client newclient.Client, feed IFeed) (IFeed, error) {
 
 // DeleteByID will delete a account with the provided id.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
-	return newclient.DeleteByID(client, template, spaceID, id)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, id)
 }
 
 // GetAll returns all feeds. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]IFeed, error) {
-	items, err := newclient.GetAll[FeedResource](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]IFeed, error) {
+	items, err := newclient.GetAllWithContext[FeedResource](ctx, client, template, spaceID)
 	return ToFeedArray(items), err
 }
@@ -4,3 +4,17 @@ type Form struct {
 	Elements []*FormElement    `json:"Elements"`
 	Values   map[string]string `json:"Values,omitempty"`
 }
+
+// GetElement returns the form element with the given name, or nil if the form
+// has no such element.
+func (f *Form) GetElement(name string) *FormElement {
+	if f == nil {
+		return nil
+	}
+	for _, element := range f.Elements {
+		if element != nil && element.Name == name {
+			return element
+		}
+	}
+	return nil
+}
@@ -5,3 +5,26 @@ type FormElement struct {
 	IsValueRequired *bool   `json:"IsValueRequired"`
 	Name            string  `json:"Name,omitempty"`
 }
+
+// GetButtonValues returns the values of the buttons in a SubmitButtonGroup
+// control. It returns nil for any other kind of control.
+func (e *FormElement) GetButtonValues() []string {
+	control, ok := e.Control.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	buttons, ok := control["Buttons"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := make([]string, 0, len(buttons))
+	for _, button := range buttons {
+		if b, ok := button.(map[string]interface{}); ok {
+			if value, ok := b["Value"].(string); ok {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
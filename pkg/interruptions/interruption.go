@@ -32,3 +32,39 @@ func NewInterruption() *Interruption {
 
 const ManualInterverventionApprove = "Proceed"
 const ManualInterventionDecline = "Abort"
+
+// Form element names used by the server for the built-in interruption types.
+const (
+	FormElementGuidance = "Guidance"
+	FormElementNotes    = "Notes"
+	FormElementResult   = "Result"
+)
+
+// Guidance values accepted by a guided failure interruption.
+const (
+	GuidedFailureAbort   = "Abort"
+	GuidedFailureExclude = "Exclude"
+	GuidedFailureIgnore  = "Ignore"
+	GuidedFailureRetry   = "Retry"
+)
+
+// InterruptionType identifies the kind of prompt an interruption represents.
+type InterruptionType string
+
+const (
+	InterruptionTypeGuidedFailure      = InterruptionType("GuidedFailure")
+	InterruptionTypeManualIntervention = InterruptionType("ManualIntervention")
+	InterruptionTypeUnknown            = InterruptionType("Unknown")
+)
+
+// Type inspects the form of the interruption to determine whether it is a
+// manual intervention or a guided failure prompt.
+func (i *Interruption) Type() InterruptionType {
+	if i.Form.GetElement(FormElementGuidance) != nil {
+		return InterruptionTypeGuidedFailure
+	}
+	if i.Form.GetElement(FormElementResult) != nil {
+		return InterruptionTypeManualIntervention
+	}
+	return InterruptionTypeUnknown
+}
@@ -1,8 +1,13 @@
 package interruptions
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
@@ -99,3 +104,113 @@ func (s InterruptionService) TakeResponsibility(resource *Interruption) (*users.
 	}
 	return resp.(*users.User), nil
 }
+
+// --- new ---
+
+const (
+	template                = "/api/{spaceId}/interruptions{/id}{?skip,take,regarding,pendingOnly,ids}"
+	submitTemplate          = "/api/{spaceId}/interruptions/{id}/submit"
+	responsibleUserTemplate = "/api/{spaceId}/interruptions/{id}/responsible"
+)
+
+// Get returns a collection of interruptions based on the criteria defined by
+// its input query parameter.
+func Get(client newclient.Client, spaceID string, interruptionsQuery InterruptionsQuery) (*resources.Resources[*Interruption], error) {
+	return GetWithContext(context.Background(), client, spaceID, interruptionsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, interruptionsQuery InterruptionsQuery) (*resources.Resources[*Interruption], error) {
+	return newclient.GetByQueryWithContext[Interruption](ctx, client, template, spaceID, interruptionsQuery)
+}
+
+// Iterate returns an iterator over the interruptions matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query InterruptionsQuery) iter.Seq2[*Interruption, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query InterruptionsQuery) iter.Seq2[*Interruption, error] {
+	return newclient.IterateByQueryWithContext[Interruption](ctx, client, template, spaceID, query)
+}
+
+// GetByID returns the interruption that matches the input ID. If one cannot be
+// found, it returns nil and an error.
+func GetByID(client newclient.Client, spaceID string, ID string) (*Interruption, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Interruption, error) {
+	return newclient.GetByIDWithContext[Interruption](ctx, client, template, spaceID, ID)
+}
+
+// GetPendingForTask returns the interruptions which are waiting for a response
+// for the task that matches the input ID.
+func GetPendingForTask(client newclient.Client, spaceID string, taskID string) ([]*Interruption, error) {
+	return GetPendingForTaskWithContext(context.Background(), client, spaceID, taskID)
+}
+
+// GetPendingForTaskWithContext is like GetPendingForTask, but uses ctx to control cancellation of the HTTP requests.
+func GetPendingForTaskWithContext(ctx context.Context, client newclient.Client, spaceID string, taskID string) ([]*Interruption, error) {
+	if internal.IsEmpty(taskID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("taskID")
+	}
+
+	return newclient.Collect(IterateWithContext(ctx, client, spaceID, InterruptionsQuery{PendingOnly: true, Regarding: taskID}))
+}
+
+// GetResponsibleUser returns the user who is currently responsible for the
+// interruption that matches the input ID.
+func GetResponsibleUser(client newclient.Client, spaceID string, ID string) (*users.User, error) {
+	return GetResponsibleUserWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetResponsibleUserWithContext is like GetResponsibleUser, but uses ctx to control cancellation of the HTTP requests.
+func GetResponsibleUserWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*users.User, error) {
+	return newclient.GetByIDWithContext[users.User](ctx, client, responsibleUserTemplate, spaceID, ID)
+}
+
+// TakeResponsibility assigns the interruption that matches the input ID to the
+// current user. Only users in one of the responsible teams can take
+// responsibility for an interruption.
+func TakeResponsibility(client newclient.Client, spaceID string, ID string) (*users.User, error) {
+	return TakeResponsibilityWithContext(context.Background(), client, spaceID, ID)
+}
+
+// TakeResponsibilityWithContext is like TakeResponsibility, but uses ctx to control cancellation of the HTTP requests.
+func TakeResponsibilityWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*users.User, error) {
+	return newclient.UpdateWithContext[users.User](ctx, client, responsibleUserTemplate, spaceID, ID, struct{}{})
+}
+
+// Submit submits form values for the interruption that matches the input ID.
+// Only the user with responsibility for the interruption can submit it.
+func Submit(client newclient.Client, spaceID string, ID string, values map[string]string) (*Interruption, error) {
+	return SubmitWithContext(context.Background(), client, spaceID, ID, values)
+}
+
+// SubmitWithContext is like Submit, but uses ctx to control cancellation of the HTTP requests.
+func SubmitWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, values map[string]string) (*Interruption, error) {
+	if internal.IsEmpty(ID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
+	}
+	if len(values) == 0 {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("values")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := client.URITemplateCache().Expand(submitTemplate, map[string]any{
+		"spaceId": spaceID,
+		"id":      ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.PostWithContext[Interruption](ctx, client.HttpSession(), path, values)
+}
@@ -0,0 +1,112 @@
+package interruptions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// Approve proceeds past a manual intervention, recording the notes against it.
+// Responsibility for the interruption is taken first if required.
+func Approve(client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return ApproveWithContext(context.Background(), client, interruption, notes)
+}
+
+// ApproveWithContext is like Approve, but uses ctx to control cancellation of the HTTP requests.
+func ApproveWithContext(ctx context.Context, client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return respond(ctx, client, interruption, InterruptionTypeManualIntervention, FormElementResult, ManualInterverventionApprove, notes)
+}
+
+// Abort fails the deployment or runbook run waiting on either a manual
+// intervention or a guided failure prompt, recording the notes against it.
+// Responsibility for the interruption is taken first if required.
+func Abort(client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return AbortWithContext(context.Background(), client, interruption, notes)
+}
+
+// AbortWithContext is like Abort, but uses ctx to control cancellation of the HTTP requests.
+func AbortWithContext(ctx context.Context, client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	if interruption != nil && interruption.Type() == InterruptionTypeGuidedFailure {
+		return respond(ctx, client, interruption, InterruptionTypeGuidedFailure, FormElementGuidance, GuidedFailureAbort, notes)
+	}
+	return respond(ctx, client, interruption, InterruptionTypeManualIntervention, FormElementResult, ManualInterventionDecline, notes)
+}
+
+// Retry responds to a guided failure prompt by retrying the failed step.
+// Responsibility for the interruption is taken first if required.
+func Retry(client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return RetryWithContext(context.Background(), client, interruption, notes)
+}
+
+// RetryWithContext is like Retry, but uses ctx to control cancellation of the HTTP requests.
+func RetryWithContext(ctx context.Context, client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return respond(ctx, client, interruption, InterruptionTypeGuidedFailure, FormElementGuidance, GuidedFailureRetry, notes)
+}
+
+// Ignore responds to a guided failure prompt by ignoring the failure and
+// continuing. Responsibility for the interruption is taken first if required.
+func Ignore(client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return IgnoreWithContext(context.Background(), client, interruption, notes)
+}
+
+// IgnoreWithContext is like Ignore, but uses ctx to control cancellation of the HTTP requests.
+func IgnoreWithContext(ctx context.Context, client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return respond(ctx, client, interruption, InterruptionTypeGuidedFailure, FormElementGuidance, GuidedFailureIgnore, notes)
+}
+
+// Exclude responds to a guided failure prompt by excluding the failed machine
+// from the remainder of the deployment. Responsibility for the interruption is
+// taken first if required.
+func Exclude(client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return ExcludeWithContext(context.Background(), client, interruption, notes)
+}
+
+// ExcludeWithContext is like Exclude, but uses ctx to control cancellation of the HTTP requests.
+func ExcludeWithContext(ctx context.Context, client newclient.Client, interruption *Interruption, notes string) (*Interruption, error) {
+	return respond(ctx, client, interruption, InterruptionTypeGuidedFailure, FormElementGuidance, GuidedFailureExclude, notes)
+}
+
+// respond validates that value is one the interruption's form accepts for the
+// given element, takes responsibility if the current user doesn't already
+// have it, and submits the form.
+func respond(ctx context.Context, client newclient.Client, interruption *Interruption, interruptionType InterruptionType, elementName string, value string, notes string) (*Interruption, error) {
+	if interruption == nil {
+		return nil, internal.CreateInvalidParameterError("respond", "interruption")
+	}
+	if !interruption.IsPending {
+		return nil, fmt.Errorf("interruption %s is no longer pending", interruption.GetID())
+	}
+	if actualType := interruption.Type(); actualType != interruptionType {
+		return nil, fmt.Errorf("interruption %s is a %s, not a %s", interruption.GetID(), actualType, interruptionType)
+	}
+
+	element := interruption.Form.GetElement(elementName)
+	if !containsValue(element.GetButtonValues(), value) {
+		return nil, fmt.Errorf("interruption %s does not accept %s as a value for %s; valid values are %v", interruption.GetID(), value, elementName, element.GetButtonValues())
+	}
+
+	if !interruption.HasResponsibility {
+		if !interruption.CanTakeResponsibility {
+			return nil, fmt.Errorf("the current user cannot take responsibility for interruption %s", interruption.GetID())
+		}
+		if _, err := TakeResponsibilityWithContext(ctx, client, interruption.SpaceID, interruption.GetID()); err != nil {
+			return nil, err
+		}
+	}
+
+	return SubmitWithContext(ctx, client, interruption.SpaceID, interruption.GetID(), map[string]string{
+		elementName:      value,
+		FormElementNotes: notes,
+	})
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package interruptions
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+const manualInterventionJSON = `{
+	"Id": "Interruptions-1",
+	"SpaceId": "Spaces-1",
+	"IsPending": true,
+	"CanTakeResponsibility": true,
+	"HasResponsibility": false,
+	"TaskId": "ServerTasks-1",
+	"Form": {
+		"Elements": [
+			{"Name": "Instructions", "Control": {"Type": "Paragraph", "Text": "Manual Approval"}},
+			{"Name": "Notes", "Control": {"Type": "TextArea", "Label": "Notes"}},
+			{"Name": "Result", "Control": {"Type": "SubmitButtonGroup", "Buttons": [
+				{"Text": "Proceed", "Value": "Proceed"},
+				{"Text": "Abort", "Value": "Abort", "RequiresConfirmation": true}
+			]}}
+		]
+	}
+}`
+
+const guidedFailureJSON = `{
+	"Id": "Interruptions-2",
+	"SpaceId": "Spaces-1",
+	"IsPending": true,
+	"CanTakeResponsibility": true,
+	"HasResponsibility": true,
+	"TaskId": "ServerTasks-1",
+	"Form": {
+		"Elements": [
+			{"Name": "Notes", "Control": {"Type": "TextArea", "Label": "Notes"}},
+			{"Name": "Guidance", "Control": {"Type": "SubmitButtonGroup", "Buttons": [
+				{"Text": "Fail", "Value": "Abort"},
+				{"Text": "Retry", "Value": "Retry"},
+				{"Text": "Ignore", "Value": "Ignore"},
+				{"Text": "Exclude machine from deployment", "Value": "Exclude"}
+			]}}
+		]
+	}
+}`
+
+type recordedRequest struct {
+	method string
+	path   string
+	body   map[string]string
+}
+
+// newRecordingHandler serves every request with a user, and records the
+// requests it receives.
+func newRecordingHandler(t *testing.T, requests *[]recordedRequest) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := recordedRequest{method: r.Method, path: r.URL.Path}
+		if r.Method == http.MethodPost {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request.body))
+		}
+		*requests = append(*requests, request)
+		_, _ = w.Write([]byte(`{"Id":"Users-1"}`))
+	})
+}
+
+func newTestInterruption(t *testing.T, content string) *Interruption {
+	interruption := NewInterruption()
+	require.NoError(t, json.Unmarshal([]byte(content), interruption))
+	return interruption
+}
+
+func TestGetPendingForTask(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/interruptions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "true", r.URL.Query().Get("pendingOnly"))
+		require.Equal(t, "ServerTasks-1", r.URL.Query().Get("regarding"))
+		if r.URL.Query().Get("skip") == "" {
+			_, _ = w.Write([]byte(`{"Items": [` + manualInterventionJSON + `], "Links": {"Page.Next": "/api/Spaces-1/interruptions?skip=1&pendingOnly=true&regarding=ServerTasks-1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items": [` + guidedFailureJSON + `], "Links": {}}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	pending, err := GetPendingForTask(client, "Spaces-1", "ServerTasks-1")
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, "Interruptions-2", pending[1].GetID())
+
+	_, err = GetPendingForTask(client, "Spaces-1", "")
+	require.Error(t, err)
+}
+
+func TestInterruptionType(t *testing.T) {
+	require.Equal(t, InterruptionTypeManualIntervention, newTestInterruption(t, manualInterventionJSON).Type())
+	require.Equal(t, InterruptionTypeGuidedFailure, newTestInterruption(t, guidedFailureJSON).Type())
+	require.Equal(t, InterruptionTypeUnknown, NewInterruption().Type())
+}
+
+func TestApproveTakesResponsibilityFirst(t *testing.T) {
+	var requests []recordedRequest
+	client := testutil.NewTestClient(t, newRecordingHandler(t, &requests))
+
+	_, err := Approve(client, newTestInterruption(t, manualInterventionJSON), "looks good")
+	require.NoError(t, err)
+
+	require.Len(t, requests, 2)
+	require.Equal(t, http.MethodPut, requests[0].method)
+	require.Equal(t, "/api/Spaces-1/interruptions/Interruptions-1/responsible", requests[0].path)
+	require.Equal(t, http.MethodPost, requests[1].method)
+	require.Equal(t, "/api/Spaces-1/interruptions/Interruptions-1/submit", requests[1].path)
+	require.Equal(t, map[string]string{"Result": "Proceed", "Notes": "looks good"}, requests[1].body)
+}
+
+func TestAbortGuidedFailure(t *testing.T) {
+	var requests []recordedRequest
+	client := testutil.NewTestClient(t, newRecordingHandler(t, &requests))
+
+	_, err := Abort(client, newTestInterruption(t, guidedFailureJSON), "")
+	require.NoError(t, err)
+
+	// responsibility is already held, so only the submission is sent
+	require.Len(t, requests, 1)
+	require.Equal(t, map[string]string{"Guidance": "Abort", "Notes": ""}, requests[0].body)
+}
+
+func TestGuidedFailureResponses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		respond  func(newclient.Client, *Interruption, string) (*Interruption, error)
+		guidance string
+	}{
+		{"Retry", Retry, GuidedFailureRetry},
+		{"Ignore", Ignore, GuidedFailureIgnore},
+		{"Exclude", Exclude, GuidedFailureExclude},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests []recordedRequest
+			client := testutil.NewTestClient(t, newRecordingHandler(t, &requests))
+
+			_, err := tc.respond(client, newTestInterruption(t, guidedFailureJSON), "notes")
+			require.NoError(t, err)
+			require.Len(t, requests, 1)
+			require.Equal(t, tc.guidance, requests[0].body[FormElementGuidance])
+		})
+	}
+}
+
+func TestRespondRejectsWrongInterruptionType(t *testing.T) {
+	var requests []recordedRequest
+	client := testutil.NewTestClient(t, newRecordingHandler(t, &requests))
+
+	_, err := Retry(client, newTestInterruption(t, manualInterventionJSON), "")
+	require.Error(t, err)
+
+	_, err = Approve(client, newTestInterruption(t, guidedFailureJSON), "")
+	require.Error(t, err)
+
+	interruption := newTestInterruption(t, manualInterventionJSON)
+	interruption.IsPending = false
+	_, err = Approve(client, interruption, "")
+	require.Error(t, err)
+
+	require.Empty(t, requests)
+}
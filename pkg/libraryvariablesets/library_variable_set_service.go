@@ -1,6 +1,9 @@
 package libraryvariablesets
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -11,6 +14,11 @@ import (
 
 // Add creates a new library variable set.
 func Add(client newclient.Client, libraryVariableSet *variables.LibraryVariableSet) (*variables.LibraryVariableSet, error) {
+	return AddWithContext(context.Background(), client, libraryVariableSet)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, libraryVariableSet *variables.LibraryVariableSet) (*variables.LibraryVariableSet, error) {
 	if libraryVariableSet == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterLibraryVariableSet)
 	}
@@ -25,13 +33,18 @@ func Add(client newclient.Client, libraryVariableSet *variables.LibraryVariableS
 		return nil, err
 	}
 
-	return newclient.Post[variables.LibraryVariableSet](client.HttpSession(), expandedUri, libraryVariableSet)
+	return newclient.PostWithContext[variables.LibraryVariableSet](ctx, client.HttpSession(), expandedUri, libraryVariableSet)
 }
 
 // Get returns a collection of library variable sets based on the criteria
 // defined by its input query parameter. If an error occurs, an empty
 // collection is returned along with the associated error.
 func Get(client newclient.Client, spaceID string, libraryVariablesQuery variables.LibraryVariablesQuery) (*resources.Resources[*variables.LibraryVariableSet], error) {
+	return GetWithContext(context.Background(), client, spaceID, libraryVariablesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, libraryVariablesQuery variables.LibraryVariablesQuery) (*resources.Resources[*variables.LibraryVariableSet], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -48,7 +61,7 @@ func Get(client newclient.Client, spaceID string, libraryVariablesQuery variable
 		return nil, err
 	}
 
-	res, err := newclient.Get[resources.Resources[*variables.LibraryVariableSet]](client.HttpSession(), expandedUri)
+	res, err := newclient.GetWithContext[resources.Resources[*variables.LibraryVariableSet]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*variables.LibraryVariableSet]{}, err
 	}
@@ -56,9 +69,26 @@ func Get(client newclient.Client, spaceID string, libraryVariablesQuery variable
 	return res, nil
 }
 
+// Iterate returns an iterator over the library variable sets matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query variables.LibraryVariablesQuery) iter.Seq2[*variables.LibraryVariableSet, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query variables.LibraryVariablesQuery) iter.Seq2[*variables.LibraryVariableSet, error] {
+	return newclient.IterateByQueryWithContext[variables.LibraryVariableSet](ctx, client, uritemplates.LibraryVariableSets, spaceID, query)
+}
+
 // GetByID returns the library variable set that matches the space ID and input ID. If one
 // cannot be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (*variables.LibraryVariableSet, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (*variables.LibraryVariableSet, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -76,11 +106,16 @@ func GetByID(client newclient.Client, spaceID string, id string) (*variables.Lib
 		return nil, err
 	}
 
-	return newclient.Get[variables.LibraryVariableSet](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[variables.LibraryVariableSet](ctx, client.HttpSession(), expandedUri)
 }
 
 // Update modifies a library variable set based on the one provided as input.
 func Update(client newclient.Client, libraryVariableSet *variables.LibraryVariableSet) (*variables.LibraryVariableSet, error) {
+	return UpdateWithContext(context.Background(), client, libraryVariableSet)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, libraryVariableSet *variables.LibraryVariableSet) (*variables.LibraryVariableSet, error) {
 	if libraryVariableSet == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterLibraryVariableSet)
 	}
@@ -98,11 +133,16 @@ func Update(client newclient.Client, libraryVariableSet *variables.LibraryVariab
 		return nil, err
 	}
 
-	return newclient.Put[variables.LibraryVariableSet](client.HttpSession(), expandedUri, libraryVariableSet)
+	return newclient.PutWithContext[variables.LibraryVariableSet](ctx, client.HttpSession(), expandedUri, libraryVariableSet)
 }
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -115,5 +155,5 @@ func DeleteByID(client newclient.Client, spaceID string, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
@@ -1,6 +1,8 @@
 package lifecycles
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -202,30 +204,72 @@ const template = "/api/{spaceId}/lifecycles{/id}{?skip,take,ids,partialName}"
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func Get(client newclient.Client, spaceID string, lifecyclesQuery Query) (*resources.Resources[*Lifecycle], error) {
-	return newclient.GetByQuery[Lifecycle](client, template, spaceID, lifecyclesQuery)
+	return GetWithContext(context.Background(), client, spaceID, lifecyclesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, lifecyclesQuery Query) (*resources.Resources[*Lifecycle], error) {
+	return newclient.GetByQueryWithContext[Lifecycle](ctx, client, template, spaceID, lifecyclesQuery)
+}
+
+// Iterate returns an iterator over the lifecycles matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query Query) iter.Seq2[*Lifecycle, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query Query) iter.Seq2[*Lifecycle, error] {
+	return newclient.IterateByQueryWithContext[Lifecycle](ctx, client, template, spaceID, query)
 }
 
 // Add creates a new lifecycle.
 func Add(client newclient.Client, lifecycle *Lifecycle) (*Lifecycle, error) {
-	return newclient.Add[Lifecycle](client, template, lifecycle.SpaceID, lifecycle)
+	return AddWithContext(context.Background(), client, lifecycle)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, lifecycle *Lifecycle) (*Lifecycle, error) {
+	return newclient.AddWithContext[Lifecycle](ctx, client, template, lifecycle.SpaceID, lifecycle)
 }
 
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a lifecycle based on the one provided as input.
 func Update(client newclient.Client, lifecycle *Lifecycle) (*Lifecycle, error) {
-	return newclient.Update[Lifecycle](client, template, lifecycle.SpaceID, lifecycle.ID, lifecycle)
+	return UpdateWithContext(context.Background(), client, lifecycle)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, lifecycle *Lifecycle) (*Lifecycle, error) {
+	return newclient.UpdateWithContext[Lifecycle](ctx, client, template, lifecycle.SpaceID, lifecycle.ID, lifecycle)
 }
 
 // GetByID returns the lifecycle that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Lifecycle, error) {
-	return newclient.GetByID[Lifecycle](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Lifecycle, error) {
+	return newclient.GetByIDWithContext[Lifecycle](ctx, client, template, spaceID, ID)
 }
 
 // GetAll returns all lifecycles. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*Lifecycle, error) {
-	return newclient.GetAll[Lifecycle](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*Lifecycle, error) {
+	return newclient.GetAllWithContext[Lifecycle](ctx, client, template, spaceID)
 }
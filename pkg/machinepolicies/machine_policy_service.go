@@ -1,6 +1,9 @@
 package machinepolicies
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 )
@@ -11,32 +14,74 @@ const template = "/api/{spaceId}/machinepolicies{/id}{?skip,take,ids,partialName
 // by its input query parameter. If an error occurs, an empty collection is
 // returned along with the associated error.
 func Get(client newclient.Client, spaceID string, machinePoliciesQuery MachinePoliciesQuery) (*resources.Resources[*MachinePolicy], error) {
-	return newclient.GetByQuery[MachinePolicy](client, template, spaceID, machinePoliciesQuery)
+	return GetWithContext(context.Background(), client, spaceID, machinePoliciesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, machinePoliciesQuery MachinePoliciesQuery) (*resources.Resources[*MachinePolicy], error) {
+	return newclient.GetByQueryWithContext[MachinePolicy](ctx, client, template, spaceID, machinePoliciesQuery)
+}
+
+// Iterate returns an iterator over the machine policies matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query MachinePoliciesQuery) iter.Seq2[*MachinePolicy, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query MachinePoliciesQuery) iter.Seq2[*MachinePolicy, error] {
+	return newclient.IterateByQueryWithContext[MachinePolicy](ctx, client, template, spaceID, query)
 }
 
 // Add creates a new machine policy.
 func Add(client newclient.Client, machinePolicy *MachinePolicy) (*MachinePolicy, error) {
-	return newclient.Add[MachinePolicy](client, template, machinePolicy.SpaceID, machinePolicy)
+	return AddWithContext(context.Background(), client, machinePolicy)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, machinePolicy *MachinePolicy) (*MachinePolicy, error) {
+	return newclient.AddWithContext[MachinePolicy](ctx, client, template, machinePolicy.SpaceID, machinePolicy)
 }
 
 // GetByID returns the machine policy that matches the input ID. If one cannot
 // be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (*MachinePolicy, error) {
-	return newclient.GetByID[MachinePolicy](client, template, spaceID, id)
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (*MachinePolicy, error) {
+	return newclient.GetByIDWithContext[MachinePolicy](ctx, client, template, spaceID, id)
 }
 
 // Update modifies a machine policy based on the one provided as input.
 func Update(client newclient.Client, machinePolicy *MachinePolicy) (*MachinePolicy, error) {
-	return newclient.Update[MachinePolicy](client, template, machinePolicy.SpaceID, machinePolicy.ID, machinePolicy)
+	return UpdateWithContext(context.Background(), client, machinePolicy)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, machinePolicy *MachinePolicy) (*MachinePolicy, error) {
+	return newclient.UpdateWithContext[MachinePolicy](ctx, client, template, machinePolicy.SpaceID, machinePolicy.ID, machinePolicy)
 }
 
 // DeleteByID deletes a machine policy based on the provided ID.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetAll returns all machine policies. If none can be found or an error
 // occurs, it returns an empty collection.
 func GetAll(client newclient.Client, spaceID string) ([]*MachinePolicy, error) {
-	return newclient.GetAll[MachinePolicy](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*MachinePolicy, error) {
+	return newclient.GetAllWithContext[MachinePolicy](ctx, client, template, spaceID)
 }
@@ -1,11 +1,6 @@
 package machines
 
-import "time"
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
 
 // ActivityLogElement represents an activity log element.
-type ActivityLogElement struct {
-	Category    string    `json:"Category,omitempty"`
-	Detail      string    `json:"Detail,omitempty"`
-	MessageText string    `json:"MessageText,omitempty"`
-	OccurredAt  time.Time `json:"OccurredAt,omitempty"`
-}
+type ActivityLogElement = tasks.ActivityLogElement
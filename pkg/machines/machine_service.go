@@ -1,7 +1,9 @@
 package machines
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -11,6 +13,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 )
 
@@ -184,41 +187,146 @@ func (s *MachineService) Update(resource *DeploymentTarget) (*DeploymentTarget,
 	return resp.(*DeploymentTarget), nil
 }
 
+// Discover asks the server to connect to a machine and returns a deployment
+// target pre-populated with what it found, such as the endpoint and the
+// Tentacle's thumbprint. The deployment target is not saved; it can be
+// completed with roles and environments and then passed to Add.
+//
+// Deprecated: use machines.Discover
+func (s *MachineService) Discover(query DiscoverMachineQuery) (*DeploymentTarget, error) {
+	if internal.IsEmpty(s.discoverMachinePath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	template, err := uritemplates.Parse(s.discoverMachinePath)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := template.Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(DeploymentTarget), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*DeploymentTarget), nil
+}
+
 var _ services.IService = &MachineService{}
 
 // --- NEW ---
 
-const template = "/api/{spaceId}/machines{/id}{?skip,take,name,ids,partialName,roles,isDisabled,healthStatuses,commStyles,tenantIds,tenantTags,environmentIds,thumbprint,deploymentId,shellNames}"
+const (
+	template         = "/api/{spaceId}/machines{/id}{?skip,take,name,ids,partialName,roles,isDisabled,healthStatuses,commStyles,tenantIds,tenantTags,environmentIds,thumbprint,deploymentId,shellNames}"
+	discoverTemplate = "/api/{spaceId}/machines/discover{?host,port,type,proxyId}"
+)
 
 // Add creates a new machine.
 func Add(client newclient.Client, deploymentTarget *DeploymentTarget) (*DeploymentTarget, error) {
-	return newclient.Add[DeploymentTarget](client, template, deploymentTarget.SpaceID, deploymentTarget)
+	return AddWithContext(context.Background(), client, deploymentTarget)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, deploymentTarget *DeploymentTarget) (*DeploymentTarget, error) {
+	return newclient.AddWithContext[DeploymentTarget](ctx, client, template, deploymentTarget.SpaceID, deploymentTarget)
 }
 
 // Get returns a collection of machines based on the criteria defined by its
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func Get(client newclient.Client, spaceID string, machinesQuery MachinesQuery) (*resources.Resources[*DeploymentTarget], error) {
-	return newclient.GetByQuery[DeploymentTarget](client, template, spaceID, machinesQuery)
+	return GetWithContext(context.Background(), client, spaceID, machinesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, machinesQuery MachinesQuery) (*resources.Resources[*DeploymentTarget], error) {
+	return newclient.GetByQueryWithContext[DeploymentTarget](ctx, client, template, spaceID, machinesQuery)
+}
+
+// Iterate returns an iterator over the deployment targets matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query MachinesQuery) iter.Seq2[*DeploymentTarget, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query MachinesQuery) iter.Seq2[*DeploymentTarget, error] {
+	return newclient.IterateByQueryWithContext[DeploymentTarget](ctx, client, template, spaceID, query)
 }
 
 // GetByID returns the machine that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*DeploymentTarget, error) {
-	return newclient.GetByID[DeploymentTarget](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*DeploymentTarget, error) {
+	return newclient.GetByIDWithContext[DeploymentTarget](ctx, client, template, spaceID, ID)
 }
 
 // Update updates an existing machine in Octopus Deploy
 func Update(client newclient.Client, deploymentTarget *DeploymentTarget) (*DeploymentTarget, error) {
-	return newclient.Update[DeploymentTarget](client, template, deploymentTarget.SpaceID, deploymentTarget.ID, deploymentTarget)
+	return UpdateWithContext(context.Background(), client, deploymentTarget)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, deploymentTarget *DeploymentTarget) (*DeploymentTarget, error) {
+	return newclient.UpdateWithContext[DeploymentTarget](ctx, client, template, deploymentTarget.SpaceID, deploymentTarget.ID, deploymentTarget)
 }
 
 // DeleteById deletes the machine based on the ID provided.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetAll returns all machines. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*DeploymentTarget, error) {
-	return newclient.GetAll[DeploymentTarget](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*DeploymentTarget, error) {
+	return newclient.GetAllWithContext[DeploymentTarget](ctx, client, template, spaceID)
+}
+
+// Discover asks the server to connect to a machine and returns a deployment
+// target pre-populated with what it found, such as the endpoint and the
+// Tentacle's thumbprint. The deployment target is not saved; it can be
+// completed with roles and environments and then passed to Add.
+func Discover(client newclient.Client, spaceID string, query DiscoverMachineQuery) (*DeploymentTarget, error) {
+	return DiscoverWithContext(context.Background(), client, spaceID, query)
+}
+
+// DiscoverWithContext is like Discover, but uses ctx to control cancellation of the HTTP requests.
+func DiscoverWithContext(ctx context.Context, client newclient.Client, spaceID string, query DiscoverMachineQuery) (*DeploymentTarget, error) {
+	path, err := expandDiscoverPath(client, discoverTemplate, spaceID, query.Host, query)
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[DeploymentTarget](ctx, client.HttpSession(), path)
+}
+
+func expandDiscoverPath(client newclient.Client, template string, spaceID string, host string, query any) (string, error) {
+	if internal.IsEmpty(host) {
+		return "", internal.CreateRequiredParameterIsEmptyError("host")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return "", err
+	}
+
+	values, _ := uritemplates.Struct2map(query)
+	values["spaceId"] = spaceID
+	return client.URITemplateCache().Expand(template, values)
 }
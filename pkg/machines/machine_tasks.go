@@ -0,0 +1,271 @@
+package machines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+// ErrNoMachinesSelected is returned by RunHealthCheck and UpgradeTentacles
+// when the selection matches no deployment targets or workers.
+var ErrNoMachinesSelected = errors.New("no deployment targets or workers match the selection")
+
+const (
+	HealthStatusHasWarnings = "HasWarnings"
+	HealthStatusHealthy     = "Healthy"
+	HealthStatusUnavailable = "Unavailable"
+	HealthStatusUnhealthy   = "Unhealthy"
+	HealthStatusUnknown     = "Unknown"
+)
+
+const (
+	defaultHealthCheckTimeout        = 5 * time.Minute
+	defaultHealthCheckMachineTimeout = time.Minute
+)
+
+// MachineSelection selects the deployment targets and workers a health check
+// or Tentacle upgrade runs against. Deployment targets are selected by ID, or
+// by role and environment; when both Roles and EnvironmentIDs are set, a
+// deployment target must match both. Workers are selected by ID or by worker
+// pool. The machines selected in each way are combined.
+type MachineSelection struct {
+	MachineIDs     []string
+	Roles          []string
+	EnvironmentIDs []string
+	WorkerIDs      []string
+	WorkerPoolIDs  []string
+}
+
+// HealthCheckOptions controls the health check queued by RunHealthCheck.
+type HealthCheckOptions struct {
+	MachineSelection
+
+	// Timeout bounds the whole health check on the server. Defaults to 5m.
+	Timeout time.Duration
+
+	// MachineTimeout bounds the check of each machine. Defaults to 1m.
+	MachineTimeout time.Duration
+
+	// OnlyConnectivity skips the health check scripts of the machine
+	// policy, and only checks that each machine can be reached.
+	OnlyConnectivity bool
+}
+
+// MachineTask is a health check or Tentacle upgrade queued by RunHealthCheck
+// or UpgradeTentacles, along with the machines it runs against.
+type MachineTask struct {
+	Task *tasks.Task
+
+	// MachineIDs are the deployment targets the task runs against.
+	MachineIDs []string
+
+	// WorkerIDs are the workers the task runs against.
+	WorkerIDs []string
+}
+
+// MachineHealth is the health of a deployment target or worker after a
+// health check or Tentacle upgrade completes.
+type MachineHealth struct {
+	MachineID     string
+	Name          string
+	IsWorker      bool
+	IsDisabled    bool
+	HealthStatus  string
+	StatusSummary string
+}
+
+// MachineTaskResult is the result of a health check or Tentacle upgrade,
+// reported by WaitForMachineTask.
+type MachineTaskResult struct {
+	Task *tasks.Task
+
+	// Machines are the deployment targets followed by the workers the task
+	// ran against, in the order they were selected.
+	Machines []*MachineHealth
+}
+
+// ByHealthStatus groups the machines by their health status.
+func (r *MachineTaskResult) ByHealthStatus() map[string][]*MachineHealth {
+	groups := map[string][]*MachineHealth{}
+	for _, machine := range r.Machines {
+		groups[machine.HealthStatus] = append(groups[machine.HealthStatus], machine)
+	}
+	return groups
+}
+
+// RunHealthCheck queues a health check of the selected deployment targets and
+// workers. Use WaitForMachineTask to wait for it to complete and read the
+// health of each machine.
+func RunHealthCheck(client newclient.Client, spaceID string, options *HealthCheckOptions) (*MachineTask, error) {
+	return RunHealthCheckWithContext(context.Background(), client, spaceID, options)
+}
+
+// RunHealthCheckWithContext is like RunHealthCheck, but uses ctx to control cancellation of the HTTP requests.
+func RunHealthCheckWithContext(ctx context.Context, client newclient.Client, spaceID string, options *HealthCheckOptions) (*MachineTask, error) {
+	if options == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("options")
+	}
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = defaultHealthCheckTimeout
+	}
+	machineTimeout := options.MachineTimeout
+	if machineTimeout <= 0 {
+		machineTimeout = defaultHealthCheckMachineTimeout
+	}
+
+	return queueMachineTask(ctx, client, spaceID, &options.MachineSelection, "Health", "Check health of %d machine(s)", map[string]any{
+		"Timeout":            ToTimeSpan(timeout),
+		"MachineTimeout":     ToTimeSpan(machineTimeout),
+		"OnlyTestConnection": options.OnlyConnectivity,
+	})
+}
+
+// UpgradeTentacles queues an upgrade of the Tentacle agents of the selected
+// deployment targets and workers to the version bundled with the server. Use
+// WaitForMachineTask to wait for it to complete and read the health of each
+// machine.
+func UpgradeTentacles(client newclient.Client, spaceID string, selection *MachineSelection) (*MachineTask, error) {
+	return UpgradeTentaclesWithContext(context.Background(), client, spaceID, selection)
+}
+
+// UpgradeTentaclesWithContext is like UpgradeTentacles, but uses ctx to control cancellation of the HTTP requests.
+func UpgradeTentaclesWithContext(ctx context.Context, client newclient.Client, spaceID string, selection *MachineSelection) (*MachineTask, error) {
+	if selection == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("selection")
+	}
+	return queueMachineTask(ctx, client, spaceID, selection, "Upgrade", "Upgrade Tentacle on %d machine(s)", map[string]any{})
+}
+
+func queueMachineTask(ctx context.Context, client newclient.Client, spaceID string, selection *MachineSelection, name string, description string, arguments map[string]any) (*MachineTask, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	machineIDs, workerIDs, err := resolveMachineSelection(ctx, client, spaceID, selection)
+	if err != nil {
+		return nil, err
+	}
+	if len(machineIDs) == 0 && len(workerIDs) == 0 {
+		return nil, ErrNoMachinesSelected
+	}
+
+	// the server treats workers as machines, so both are passed as MachineIds
+	task := tasks.NewTask()
+	task.Name = name
+	task.Description = fmt.Sprintf(description, len(machineIDs)+len(workerIDs))
+	task.SpaceID = spaceID
+	task.Arguments = arguments
+	task.Arguments["MachineIds"] = slices.Concat(machineIDs, workerIDs)
+
+	task, err = tasks.AddWithContext(ctx, client, task)
+	if err != nil {
+		return nil, err
+	}
+	return &MachineTask{Task: task, MachineIDs: machineIDs, WorkerIDs: workerIDs}, nil
+}
+
+func resolveMachineSelection(ctx context.Context, client newclient.Client, spaceID string, selection *MachineSelection) ([]string, []string, error) {
+	machineIDs := appendUnique([]string{}, selection.MachineIDs...)
+	if len(selection.Roles) > 0 || len(selection.EnvironmentIDs) > 0 {
+		query := MachinesQuery{Roles: selection.Roles, EnvironmentIDs: selection.EnvironmentIDs}
+		for deploymentTarget, err := range IterateWithContext(ctx, client, spaceID, query) {
+			if err != nil {
+				return nil, nil, err
+			}
+			machineIDs = appendUnique(machineIDs, deploymentTarget.GetID())
+		}
+	}
+
+	workerIDs := appendUnique([]string{}, selection.WorkerIDs...)
+	if len(selection.WorkerPoolIDs) > 0 {
+		query := WorkersQuery{WorkerPoolIDs: selection.WorkerPoolIDs}
+		for worker, err := range newclient.IterateByQueryWithContext[Worker](ctx, client, workersTemplate, spaceID, query) {
+			if err != nil {
+				return nil, nil, err
+			}
+			workerIDs = appendUnique(workerIDs, worker.GetID())
+		}
+	}
+	return machineIDs, workerIDs, nil
+}
+
+func appendUnique(IDs []string, additional ...string) []string {
+	for _, ID := range additional {
+		if !internal.IsEmpty(ID) && !slices.Contains(IDs, ID) {
+			IDs = append(IDs, ID)
+		}
+	}
+	return IDs
+}
+
+// WaitForMachineTask waits for a health check or Tentacle upgrade to complete,
+// then reads the health of each machine it ran against. If the task does not
+// succeed, the result is returned along with a *tasks.TaskError, as machines
+// which could not be checked are reported with their last known health.
+func WaitForMachineTask(client newclient.Client, spaceID string, machineTask *MachineTask, options *tasks.WaitOptions) (*MachineTaskResult, error) {
+	return WaitForMachineTaskWithContext(context.Background(), client, spaceID, machineTask, options)
+}
+
+// WaitForMachineTaskWithContext is like WaitForMachineTask, but stops waiting when ctx is done.
+func WaitForMachineTaskWithContext(ctx context.Context, client newclient.Client, spaceID string, machineTask *MachineTask, options *tasks.WaitOptions) (*MachineTaskResult, error) {
+	if machineTask == nil || machineTask.Task == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("machineTask")
+	}
+
+	task, err := tasks.WaitForTaskWithContext(ctx, client, spaceID, machineTask.Task.GetID(), options)
+	var taskErr *tasks.TaskError
+	if err != nil && !errors.As(err, &taskErr) {
+		return nil, err
+	}
+
+	health := map[string]*MachineHealth{}
+	if len(machineTask.MachineIDs) > 0 {
+		deploymentTargets, getErr := newclient.GetByQueryWithContext[DeploymentTarget](ctx, client, template, spaceID, MachinesQuery{IDs: machineTask.MachineIDs, Take: len(machineTask.MachineIDs)})
+		if getErr != nil {
+			return nil, getErr
+		}
+		for _, deploymentTarget := range deploymentTargets.Items {
+			health[deploymentTarget.GetID()] = newMachineHealth(&deploymentTarget.machine, false)
+		}
+	}
+	if len(machineTask.WorkerIDs) > 0 {
+		workers, getErr := newclient.GetByQueryWithContext[Worker](ctx, client, workersTemplate, spaceID, WorkersQuery{IDs: machineTask.WorkerIDs, Take: len(machineTask.WorkerIDs)})
+		if getErr != nil {
+			return nil, getErr
+		}
+		for _, worker := range workers.Items {
+			health[worker.GetID()] = newMachineHealth(&worker.machine, true)
+		}
+	}
+
+	// machines deleted while the task ran are left out
+	result := &MachineTaskResult{Task: task, Machines: []*MachineHealth{}}
+	for _, ID := range slices.Concat(machineTask.MachineIDs, machineTask.WorkerIDs) {
+		if health[ID] != nil {
+			result.Machines = append(result.Machines, health[ID])
+		}
+	}
+	return result, err
+}
+
+func newMachineHealth(m *machine, isWorker bool) *MachineHealth {
+	return &MachineHealth{
+		MachineID:     m.GetID(),
+		Name:          m.Name,
+		IsWorker:      isWorker,
+		IsDisabled:    m.IsDisabled,
+		HealthStatus:  m.HealthStatus,
+		StatusSummary: m.StatusSummary,
+	}
+}
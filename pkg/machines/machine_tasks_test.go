@@ -0,0 +1,118 @@
+package machines
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscover(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/machines/discover", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "web01", r.URL.Query().Get("host"))
+		require.Equal(t, "10933", r.URL.Query().Get("port"))
+		require.Equal(t, "TentaclePassive", r.URL.Query().Get("type"))
+		_, _ = w.Write([]byte(`{"Name": "web01", "Thumbprint": "ABC123", "Endpoint": {"CommunicationStyle": "TentaclePassive", "Uri": "https://web01:10933/", "Thumbprint": "ABC123"}}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/workers/discover", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Name": "worker01", "Thumbprint": "DEF456", "Endpoint": {"CommunicationStyle": "TentaclePassive", "Uri": "https://worker01:10933/", "Thumbprint": "DEF456"}}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	deploymentTarget, err := Discover(client, "", DiscoverMachineQuery{Host: "web01", Port: 10933, Type: "TentaclePassive"})
+	require.NoError(t, err)
+	require.Equal(t, "ABC123", deploymentTarget.Thumbprint)
+	endpoint, ok := deploymentTarget.Endpoint.(*ListeningTentacleEndpoint)
+	require.True(t, ok)
+	require.Equal(t, "ABC123", endpoint.Thumbprint)
+
+	worker, err := DiscoverWorker(client, "", DiscoverWorkerQuery{Host: "worker01"})
+	require.NoError(t, err)
+	require.Equal(t, "DEF456", worker.Thumbprint)
+
+	_, err = Discover(client, "", DiscoverMachineQuery{})
+	require.Error(t, err)
+}
+
+func TestRunHealthCheck(t *testing.T) {
+	var queued map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/machines", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("roles") != "" {
+			require.Equal(t, "web", r.URL.Query().Get("roles"))
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "Machines-1"}, {"Id": "Machines-2"}], "Links": {}}`))
+			return
+		}
+		require.Equal(t, "Machines-1,Machines-2", r.URL.Query().Get("ids"))
+		_, _ = w.Write([]byte(`{"Items": [
+			{"Id": "Machines-2", "Name": "web02", "HealthStatus": "Unavailable", "StatusSummary": "Could not connect"},
+			{"Id": "Machines-1", "Name": "web01", "HealthStatus": "Healthy"}
+		], "Links": {}}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/workers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Workers-1", "Name": "worker01", "HealthStatus": "HasWarnings"}], "Links": {}}`))
+	})
+	mux.HandleFunc("POST /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&queued))
+		_, _ = w.Write([]byte(`{"Id": "ServerTasks-1", "Name": "Health", "State": "Queued"}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-1", "State": "Failed"}]}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	machineTask, err := RunHealthCheck(client, "", &HealthCheckOptions{
+		MachineSelection: MachineSelection{MachineIDs: []string{"Machines-1"}, Roles: []string{"web"}, WorkerIDs: []string{"Workers-1"}},
+		MachineTimeout:   30 * time.Second,
+		OnlyConnectivity: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Machines-1", "Machines-2"}, machineTask.MachineIDs)
+	require.Equal(t, "Health", queued["Name"])
+	require.Equal(t, map[string]any{
+		"Timeout":            "00:05:00",
+		"MachineTimeout":     "00:00:30",
+		"OnlyTestConnection": true,
+		"MachineIds":         []any{"Machines-1", "Machines-2", "Workers-1"},
+	}, queued["Arguments"])
+
+	result, err := WaitForMachineTask(client, "", machineTask, &tasks.WaitOptions{PollInterval: time.Millisecond})
+	require.ErrorIs(t, err, tasks.ErrTaskFailed)
+	require.Len(t, result.Machines, 3)
+	require.Equal(t, "Machines-1", result.Machines[0].MachineID)
+	require.True(t, result.Machines[2].IsWorker)
+
+	byHealthStatus := result.ByHealthStatus()
+	require.Equal(t, "web01", byHealthStatus[HealthStatusHealthy][0].Name)
+	require.Equal(t, "Could not connect", byHealthStatus[HealthStatusUnavailable][0].StatusSummary)
+	require.Equal(t, "worker01", byHealthStatus[HealthStatusHasWarnings][0].Name)
+}
+
+func TestUpgradeTentacles(t *testing.T) {
+	var queued map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/workers", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "WorkerPools-1", r.URL.Query().Get("workerPoolIds"))
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Workers-1"}], "Links": {}}`))
+	})
+	mux.HandleFunc("POST /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&queued))
+		_, _ = w.Write([]byte(`{"Id": "ServerTasks-2", "Name": "Upgrade", "State": "Queued"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	machineTask, err := UpgradeTentacles(client, "", &MachineSelection{WorkerPoolIDs: []string{"WorkerPools-1"}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Workers-1"}, machineTask.WorkerIDs)
+	require.Equal(t, "Upgrade", queued["Name"])
+	require.Equal(t, map[string]any{"MachineIds": []any{"Workers-1"}}, queued["Arguments"])
+
+	_, err = UpgradeTentacles(client, "", &MachineSelection{})
+	require.True(t, errors.Is(err, ErrNoMachinesSelected))
+}
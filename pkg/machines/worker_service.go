@@ -1,13 +1,16 @@
 package machines
 
 import (
+	"context"
 	"fmt"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 	"strings"
 )
@@ -67,17 +70,34 @@ func (s *WorkerService) Add(worker *Worker) (*Worker, error) {
 	return resp.(*Worker), nil
 }
 
-// TODO: validation implementation
+// Discover asks the server to connect to a machine and returns a worker
+// pre-populated with what it found, such as the endpoint and the Tentacle's
+// thumbprint. The worker is not saved; it can be completed with worker pools
+// and then passed to Add.
+//
+// Deprecated: use machines.DiscoverWorker
+func (s *WorkerService) Discover(query DiscoverWorkerQuery) (*Worker, error) {
+	if internal.IsEmpty(s.discoverWorkerPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
 
-// func (s workerService) DiscoverWorker() ([]string, error) {
-// 	resp, err := api.ApiGet(s.GetClient(), new([]string), s.discoverWorkerPath)
-// 	if err != nil {
-// 		return nil, err
-// 	}
+	template, err := uritemplates.Parse(s.discoverWorkerPath)
+	if err != nil {
+		return nil, err
+	}
 
-// 	response := resp.(*[]string)
-// 	return *response, nil
-// }
+	path, err := template.Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(Worker), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*Worker), nil
+}
 
 // GetAll returns all workers. If none can be found or an error occurs, it
 // returns an empty collection.
@@ -221,3 +241,27 @@ func (s *WorkerService) GetWorkerShells() ([]string, error) {
 	response := resp.(*[]string)
 	return *response, nil
 }
+
+// --- new ---
+
+const (
+	workersTemplate        = "/api/{spaceId}/workers{/id}{?skip,take,name,ids,partialName,isDisabled,healthStatuses,commStyles,workerPoolIds,thumbprint,shellNames}"
+	discoverWorkerTemplate = "/api/{spaceId}/workers/discover{?host,port,type,proxyId}"
+)
+
+// DiscoverWorker asks the server to connect to a machine and returns a worker
+// pre-populated with what it found, such as the endpoint and the Tentacle's
+// thumbprint. The worker is not saved; it can be completed with worker pools
+// and then added to the server.
+func DiscoverWorker(client newclient.Client, spaceID string, query DiscoverWorkerQuery) (*Worker, error) {
+	return DiscoverWorkerWithContext(context.Background(), client, spaceID, query)
+}
+
+// DiscoverWorkerWithContext is like DiscoverWorker, but uses ctx to control cancellation of the HTTP requests.
+func DiscoverWorkerWithContext(ctx context.Context, client newclient.Client, spaceID string, query DiscoverWorkerQuery) (*Worker, error) {
+	path, err := expandDiscoverPath(client, discoverWorkerTemplate, spaceID, query.Host, query)
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[Worker](ctx, client.HttpSession(), path)
+}
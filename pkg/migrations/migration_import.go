@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/go-playground/validator/v10"
+)
+
+// MigrationImport describes the import of a package, previously produced by a
+// partial export, from the built-in package repository.
+type MigrationImport struct {
+	DeletePackageOnCompletion bool   `json:"DeletePackageOnCompletion"`
+	IsDryRun                  bool   `json:"IsDryRun"`
+	IsEncryptedPackage        bool   `json:"IsEncryptedPackage"`
+	PackageID                 string `json:"PackageId" validate:"required"`
+	PackageVersion            string `json:"PackageVersion" validate:"required"`
+	Password                  string `json:"Password" validate:"required"`
+	TaskID                    string `json:"TaskId,omitempty"`
+}
+
+// NewMigrationImport creates and initializes an import of the input package.
+// The password must match the one used when the package was exported.
+func NewMigrationImport(packageID string, packageVersion string, password string) *MigrationImport {
+	return &MigrationImport{
+		PackageID:      packageID,
+		PackageVersion: packageVersion,
+		Password:       password,
+	}
+}
+
+// Validate checks the state of the import and returns an error if invalid.
+func (i *MigrationImport) Validate() error {
+	return validator.New().Struct(i)
+}
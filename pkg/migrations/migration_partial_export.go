@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"github.com/go-playground/validator/v10"
+)
+
+// MigrationPartialExport describes a partial export of one or more projects,
+// and their dependencies, into a package in the built-in package repository.
+type MigrationPartialExport struct {
+	DestinationAPIKey             string   `json:"DestinationApiKey,omitempty"`
+	DestinationPackageFeed        string   `json:"DestinationPackageFeed,omitempty"`
+	DestinationPackageFeedSpaceID string   `json:"DestinationPackageFeedSpaceId,omitempty"`
+	EncryptPackage                bool     `json:"EncryptPackage"`
+	IgnoreCertificates            bool     `json:"IgnoreCertificates"`
+	IgnoreDeployments             bool     `json:"IgnoreDeployments"`
+	IgnoreMachines                bool     `json:"IgnoreMachines"`
+	IgnoreTenants                 bool     `json:"IgnoreTenants"`
+	IncludeTaskLogs               bool     `json:"IncludeTaskLogs"`
+	PackageID                     string   `json:"PackageId" validate:"required"`
+	PackageVersion                string   `json:"PackageVersion" validate:"required"`
+	Password                      string   `json:"Password" validate:"required"`
+	Projects                      []string `json:"Projects" validate:"required,min=1"`
+	TaskID                        string   `json:"TaskId,omitempty"`
+}
+
+// NewMigrationPartialExport creates and initializes a partial export of the
+// input projects. The password is used to encrypt sensitive values in the
+// exported package.
+func NewMigrationPartialExport(packageID string, packageVersion string, password string, projects ...string) *MigrationPartialExport {
+	return &MigrationPartialExport{
+		PackageID:      packageID,
+		PackageVersion: packageVersion,
+		Password:       password,
+		Projects:       projects,
+	}
+}
+
+// Validate checks the state of the partial export and returns an error if invalid.
+func (e *MigrationPartialExport) Validate() error {
+	return validator.New().Struct(e)
+}
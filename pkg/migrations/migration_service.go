@@ -1,8 +1,16 @@
 package migrations
 
 import (
+	"context"
+	"io"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/packages"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 )
 
@@ -20,3 +28,136 @@ func NewMigrationService(sling *sling.Sling, uriTemplate string, migrationsImpor
 		Service:                     services.NewService(constants.ServiceMigrationService, sling, uriTemplate),
 	}
 }
+
+// PartialExport submits a partial export and returns it with the ID of the
+// server task that performs it.
+func (s *MigrationService) PartialExport(partialExport *MigrationPartialExport) (*MigrationPartialExport, error) {
+	if partialExport == nil {
+		return nil, internal.CreateInvalidParameterError("PartialExport", "partialExport")
+	}
+	if err := partialExport.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("PartialExport", err)
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), partialExport, new(MigrationPartialExport), s.migrationsPartialExportPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MigrationPartialExport), nil
+}
+
+// Import submits an import and returns it with the ID of the server task that
+// performs it.
+func (s *MigrationService) Import(migrationImport *MigrationImport) (*MigrationImport, error) {
+	if migrationImport == nil {
+		return nil, internal.CreateInvalidParameterError("Import", "migrationImport")
+	}
+	if err := migrationImport.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("Import", err)
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), migrationImport, new(MigrationImport), s.migrationsImportPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*MigrationImport), nil
+}
+
+// ----- new -----
+
+// PartialExport submits a partial export and returns it with the ID of the
+// server task that performs it. Use RunPartialExport to also wait for the task
+// and download the exported package.
+func PartialExport(client newclient.Client, partialExport *MigrationPartialExport) (*MigrationPartialExport, error) {
+	return PartialExportWithContext(context.Background(), client, partialExport)
+}
+
+// PartialExportWithContext is like PartialExport, but uses ctx to control cancellation of the HTTP requests.
+func PartialExportWithContext(ctx context.Context, client newclient.Client, partialExport *MigrationPartialExport) (*MigrationPartialExport, error) {
+	if partialExport == nil {
+		return nil, internal.CreateInvalidParameterError("PartialExport", "partialExport")
+	}
+	if err := partialExport.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("PartialExport", err)
+	}
+
+	return newclient.PostWithContext[MigrationPartialExport](ctx, client.HttpSession(), uritemplates.MigrationsPartialExport, partialExport)
+}
+
+// Import submits an import and returns it with the ID of the server task that
+// performs it. Use RunImport to also wait for the task.
+func Import(client newclient.Client, migrationImport *MigrationImport) (*MigrationImport, error) {
+	return ImportWithContext(context.Background(), client, migrationImport)
+}
+
+// ImportWithContext is like Import, but uses ctx to control cancellation of the HTTP requests.
+func ImportWithContext(ctx context.Context, client newclient.Client, migrationImport *MigrationImport) (*MigrationImport, error) {
+	if migrationImport == nil {
+		return nil, internal.CreateInvalidParameterError("Import", "migrationImport")
+	}
+	if err := migrationImport.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("Import", err)
+	}
+
+	return newclient.PostWithContext[MigrationImport](ctx, client.HttpSession(), uritemplates.MigrationsImport, migrationImport)
+}
+
+// RunPartialExport submits a partial export, waits for its server task to
+// complete and then writes the exported package, downloaded from the built-in
+// package repository of the space, to w. The final state of the task is
+// returned; if the task does not succeed, nothing is written and a
+// *tasks.TaskError is returned.
+func RunPartialExport(client newclient.Client, spaceID string, partialExport *MigrationPartialExport, w io.Writer, options *tasks.WaitOptions) (*tasks.Task, error) {
+	return RunPartialExportWithContext(context.Background(), client, spaceID, partialExport, w, options)
+}
+
+// RunPartialExportWithContext is like RunPartialExport, but uses ctx to control cancellation of the HTTP requests.
+func RunPartialExportWithContext(ctx context.Context, client newclient.Client, spaceID string, partialExport *MigrationPartialExport, w io.Writer, options *tasks.WaitOptions) (*tasks.Task, error) {
+	if w == nil {
+		return nil, internal.CreateInvalidParameterError("RunPartialExport", "w")
+	}
+
+	submitted, err := PartialExportWithContext(ctx, client, partialExport)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := tasks.WaitForTaskWithContext(ctx, client, spaceID, submitted.TaskID, options)
+	if err != nil {
+		return task, err
+	}
+
+	return task, DownloadPackageWithContext(ctx, client, spaceID, partialExport.PackageID, partialExport.PackageVersion, w)
+}
+
+// RunImport submits an import and waits for its server task to complete. The
+// final state of the task is returned; if the task does not succeed, a
+// *tasks.TaskError is returned along with it.
+func RunImport(client newclient.Client, spaceID string, migrationImport *MigrationImport, options *tasks.WaitOptions) (*tasks.Task, error) {
+	return RunImportWithContext(context.Background(), client, spaceID, migrationImport, options)
+}
+
+// RunImportWithContext is like RunImport, but uses ctx to control cancellation of the HTTP requests.
+func RunImportWithContext(ctx context.Context, client newclient.Client, spaceID string, migrationImport *MigrationImport, options *tasks.WaitOptions) (*tasks.Task, error) {
+	submitted, err := ImportWithContext(ctx, client, migrationImport)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.WaitForTaskWithContext(ctx, client, spaceID, submitted.TaskID, options)
+}
+
+// DownloadPackage writes the content of a package in the built-in package
+// repository, such as one produced by a partial export, to w. It is
+// downloaded with packages.Download, so its hash is checked.
+func DownloadPackage(client newclient.Client, spaceID string, packageID string, packageVersion string, w io.Writer) error {
+	return DownloadPackageWithContext(context.Background(), client, spaceID, packageID, packageVersion, w)
+}
+
+// DownloadPackageWithContext is like DownloadPackage, but uses ctx to control cancellation of the HTTP requests.
+func DownloadPackageWithContext(ctx context.Context, client newclient.Client, spaceID string, packageID string, packageVersion string, w io.Writer) error {
+	_, err := packages.DownloadWithContext(ctx, client, spaceID, packageID, packageVersion, w, nil)
+	return err
+}
@@ -0,0 +1,101 @@
+package migrations
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTask(t *testing.T, w http.ResponseWriter, state tasks.TaskState) {
+	task := tasks.NewTask()
+	task.ID = "ServerTasks-1"
+	task.State = string(state)
+	require.NoError(t, json.NewEncoder(w).Encode(&resources.Resources[*tasks.Task]{Items: []*tasks.Task{task}}))
+}
+
+func TestRunPartialExportDownloadsPackage(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/migrations/partialexport":
+			require.Equal(t, http.MethodPost, r.Method)
+			partialExport := new(MigrationPartialExport)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(partialExport))
+			require.Equal(t, []string{"Projects-1", "Projects-2"}, partialExport.Projects)
+			require.True(t, partialExport.IncludeTaskLogs)
+			partialExport.TaskID = "ServerTasks-1"
+			require.NoError(t, json.NewEncoder(w).Encode(partialExport))
+		case "/api/Spaces-1/tasks":
+			writeTask(t, w, tasks.TaskStateSuccess)
+		case "/api/Spaces-1/packages/packages-Export.1.0.0":
+			hash := sha1.Sum([]byte("package content"))
+			_, _ = fmt.Fprintf(w, `{"Id": "packages-Export.1.0.0", "PackageId": "Export", "Version": "1.0.0", "Hash": %q}`, hex.EncodeToString(hash[:]))
+		case "/api/Spaces-1/packages/packages-Export.1.0.0/raw":
+			_, _ = w.Write([]byte("package content"))
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	partialExport := NewMigrationPartialExport("Export", "1.0.0", "secret", "Projects-1", "Projects-2")
+	partialExport.IncludeTaskLogs = true
+
+	var buffer bytes.Buffer
+	task, err := RunPartialExport(client, "Spaces-1", partialExport, &buffer, &tasks.WaitOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, "ServerTasks-1", task.ID)
+	require.Equal(t, "package content", buffer.String())
+}
+
+func TestRunPartialExportDoesNotDownloadFailedExport(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/migrations/partialexport":
+			_, _ = w.Write([]byte(`{"TaskId":"ServerTasks-1"}`))
+		case "/api/Spaces-1/tasks":
+			writeTask(t, w, tasks.TaskStateFailed)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	var buffer bytes.Buffer
+	_, err := RunPartialExport(client, "Spaces-1", NewMigrationPartialExport("Export", "1.0.0", "secret", "Projects-1"), &buffer, &tasks.WaitOptions{PollInterval: time.Millisecond})
+	require.ErrorIs(t, err, tasks.ErrTaskFailed)
+	require.Zero(t, buffer.Len())
+}
+
+func TestPartialExportRequiresProjects(t *testing.T) {
+	_, err := PartialExport(newclient.NewClient(&newclient.HttpSession{}), NewMigrationPartialExport("Export", "1.0.0", "secret"))
+	require.Error(t, err)
+}
+
+func TestRunImportWaitsForTask(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/migrations/import":
+			migrationImport := new(MigrationImport)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(migrationImport))
+			require.Equal(t, "secret", migrationImport.Password)
+			_, _ = w.Write([]byte(`{"TaskId":"ServerTasks-1"}`))
+		case "/api/Spaces-1/tasks":
+			writeTask(t, w, tasks.TaskStateSuccess)
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	task, err := RunImport(client, "Spaces-1", NewMigrationImport("Export", "1.0.0", "secret"), &tasks.WaitOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, string(tasks.TaskStateSuccess), task.State)
+}
@@ -1,6 +1,8 @@
 package newclient
 
 import (
+	"context"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
@@ -9,6 +11,11 @@ import (
 
 // DeleteByID will delete a resource with the provided id.
 func DeleteByID(client Client, template string, spaceID string, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, template, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP request.
+func DeleteByIDWithContext(ctx context.Context, client Client, template string, spaceID string, id string) error {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return err
@@ -25,11 +32,16 @@ func DeleteByID(client Client, template string, spaceID string, id string) error
 		return err
 	}
 
-	return Delete(client.HttpSession(), path)
+	return DeleteWithContext(ctx, client.HttpSession(), path)
 }
 
 // Add creates a new resource.
 func Add[TResource any](client Client, template string, spaceID string, resource any) (*TResource, error) {
+	return AddWithContext[TResource](context.Background(), client, template, spaceID, resource)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP request.
+func AddWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string, resource any) (*TResource, error) {
 	if resource == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterResource)
 	}
@@ -45,7 +57,7 @@ func Add[TResource any](client Client, template string, spaceID string, resource
 		return nil, err
 	}
 
-	res, err := Post[TResource](client.HttpSession(), path, resource)
+	res, err := PostWithContext[TResource](ctx, client.HttpSession(), path, resource)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +67,11 @@ func Add[TResource any](client Client, template string, spaceID string, resource
 
 // Update modifies a resource based on the one provided as input.
 func Update[TResource any](client Client, template string, spaceID string, ID string, resource any) (*TResource, error) {
+	return UpdateWithContext[TResource](context.Background(), client, template, spaceID, ID, resource)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP request.
+func UpdateWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string, ID string, resource any) (*TResource, error) {
 	if resource == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterResource)
 	}
@@ -72,7 +89,7 @@ func Update[TResource any](client Client, template string, spaceID string, ID st
 		return nil, err
 	}
 
-	res, err := Put[TResource](client.HttpSession(), path, resource)
+	res, err := PutWithContext[TResource](ctx, client.HttpSession(), path, resource)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +100,11 @@ func Update[TResource any](client Client, template string, spaceID string, ID st
 // GetByQuery returns a collection of resources based on the criteria defined by
 // its input query parameter.
 func GetByQuery[TResource any](client Client, template string, spaceID string, query any) (*resources.Resources[*TResource], error) {
+	return GetByQueryWithContext[TResource](context.Background(), client, template, spaceID, query)
+}
+
+// GetByQueryWithContext is like GetByQuery, but uses ctx to control cancellation of the HTTP request.
+func GetByQueryWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string, query any) (*resources.Resources[*TResource], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -97,7 +119,7 @@ func GetByQuery[TResource any](client Client, template string, spaceID string, q
 		return nil, err
 	}
 
-	res, err := Get[resources.Resources[*TResource]](client.HttpSession(), path)
+	res, err := GetWithContext[resources.Resources[*TResource]](ctx, client.HttpSession(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +129,11 @@ func GetByQuery[TResource any](client Client, template string, spaceID string, q
 
 // GetByID returns the resource that matches the input ID.
 func GetByID[TResource any](client Client, template string, spaceID string, ID string) (*TResource, error) {
+	return GetByIDWithContext[TResource](context.Background(), client, template, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP request.
+func GetByIDWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string, ID string) (*TResource, error) {
 	if ID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
 	}
@@ -123,7 +150,7 @@ func GetByID[TResource any](client Client, template string, spaceID string, ID s
 		return nil, err
 	}
 
-	res, err := Get[TResource](client.HttpSession(), path)
+	res, err := GetWithContext[TResource](ctx, client.HttpSession(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -133,28 +160,16 @@ func GetByID[TResource any](client Client, template string, spaceID string, ID s
 
 // GetAll returns all resources. If an error occurs, it returns nil.
 func GetAll[TResource any](client Client, template string, spaceID string) ([]*TResource, error) {
+	return GetAllWithContext[TResource](context.Background(), client, template, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string) ([]*TResource, error) {
 	path, err := client.URITemplateCache().Expand(template, map[string]any{
 		"spaceId": spaceID,
 	})
 	if err != nil {
 		return nil, err
 	}
-	spaces := make([]*TResource, 0)
-	res, err := Get[resources.Resources[*TResource]](client.HttpSession(), path)
-	if err != nil {
-		return nil, err
-	}
-	spaces = append(spaces, res.Items...)
-	for res.Links.PageNext != "" {
-		nextPagePath, err := client.URITemplateCache().Expand(res.Links.PageNext, map[string]any{})
-		if err != nil {
-			return nil, err
-		}
-		res, err = Get[resources.Resources[*TResource]](client.HttpSession(), nextPagePath)
-		if err != nil {
-			return nil, err
-		}
-		spaces = append(spaces, res.Items...)
-	}
-	return spaces, err
+	return Collect(IterateByPathWithContext[TResource](ctx, client, path))
 }
@@ -2,6 +2,7 @@ package newclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
@@ -16,6 +17,7 @@ import (
 // - Converting payloads to/from JSON, including our behaviour of converting HTTP 4xx/5xx responses into go error structs
 // - Helpers for convenient Get/Put/Post/etc
 // - Dealing with quirks of the go io subsystem (flushing buffers where required etc).
+// - Retrying transient failures, if a RetryPolicy is set.
 //
 // While this borrows some ideas and quirk-handling from Sling, we don't want to use Sling itself because it has a weird API,
 // and doesn't allow us access to some things that we need.
@@ -23,6 +25,8 @@ type HttpSession struct {
 	HttpClient     *http.Client
 	BaseURL        *url.URL
 	DefaultHeaders map[string]string
+	// RetryPolicy controls retries of failed requests. If nil, each request is attempted exactly once.
+	RetryPolicy *RetryPolicy
 }
 
 // DoRawRequest adds any default headers to the HTTP request, and resolve the URL against the baseURL, then performs the HTTP request.
@@ -43,7 +47,16 @@ func (h *HttpSession) DoRawRequest(req *http.Request) (*http.Response, error) {
 		req.URL = h.BaseURL.ResolveReference(req.URL)
 	}
 
-	return h.HttpClient.Do(req)
+	return h.RetryPolicy.Do(h.HttpClient, req)
+}
+
+// DoRawRequestWithContext is like DoRawRequest, but the request is bound to ctx so that it is cancelled
+// when ctx is done.
+func (h *HttpSession) DoRawRequestWithContext(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if ctx == nil {
+		return nil, errors.New("nil Context")
+	}
+	return h.DoRawRequest(req.WithContext(ctx))
 }
 
 // DoRawJsonRequest layers JSON serialization over DoRawRequest
@@ -67,6 +80,10 @@ func (h *HttpSession) DoRawJsonRequest(req *http.Request, requestBody any, outpu
 			req.Header.Set("Content-Type", "application/json")
 		}
 		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			// allows the body to be replayed if the request is retried
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
 	}
 
 	if req.Header.Get("Accept") == "" {
@@ -79,6 +96,21 @@ func (h *HttpSession) DoRawJsonRequest(req *http.Request, requestBody any, outpu
 	}
 	defer CloseResponse(resp)
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		// don't use core.APIErrorChecker, it's overly helpful and gets in the way of error handling.
+		apiError, isAPIError := outputResponseError.(*core.APIError)
+		err = json.NewDecoder(resp.Body).Decode(outputResponseError)
+		if isAPIError {
+			// an empty or non-JSON body (such as an error page from a proxy) still produces
+			// an APIError carrying the status code
+			return nil, core.NewAPIError(resp, apiError)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return nil, outputResponseError
+	}
+
 	if resp.StatusCode == http.StatusNoContent || resp.ContentLength == 0 {
 		// TODO the ContentLength check is copied from Sling, but it's valid for servers to stream responses
 		// without a known content length. This won't handle such responses, which would be a bug. The octopus server tends not
@@ -86,38 +118,29 @@ func (h *HttpSession) DoRawJsonRequest(req *http.Request, requestBody any, outpu
 		return resp, nil
 	}
 
-	bodyDecoder := json.NewDecoder(resp.Body)
-	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
-		err = bodyDecoder.Decode(outputResponseBody)
-		if err != nil {
-			return nil, err
-		}
-		return resp, nil
-	} else {
-		// don't use core.APIErrorChecker, it's overly helpful and gets in the way of error handling.
-		err = bodyDecoder.Decode(outputResponseError)
-		if err != nil {
-			return nil, err
-		}
-		if outputRes, ok := outputResponseError.(*core.APIError); ok {
-			outputRes.StatusCode = resp.StatusCode
-			return nil, outputRes
-		}
-		return nil, outputResponseError
+	err = json.NewDecoder(resp.Body).Decode(outputResponseBody)
+	if err != nil {
+		return nil, err
 	}
+	return resp, nil
 }
 
 func DoRequest[TResponse any](httpSession *HttpSession, method string, path string, body any) (*TResponse, error) {
+	return DoRequestWithContext[TResponse](context.Background(), httpSession, method, path, body)
+}
+
+// DoRequestWithContext is like DoRequest, but uses ctx to control cancellation of the HTTP request.
+func DoRequestWithContext[TResponse any](ctx context.Context, httpSession *HttpSession, method string, path string, body any) (*TResponse, error) {
 	pathUrl, err := url.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
-	req := &http.Request{
-		Method: method,
-		URL:    pathUrl,
-		// body will be assigned inside DoRawJsonRequest
+	req, err := newRequest(ctx, method, pathUrl)
+	if err != nil {
+		return nil, err
 	}
+	// body will be assigned inside DoRawJsonRequest
 
 	var responsePayload = new(TResponse)
 	var errorPayload = new(core.APIError)
@@ -134,14 +157,19 @@ func DoRequest[TResponse any](httpSession *HttpSession, method string, path stri
 }
 
 func DoDelete(httpSession *HttpSession, method string, path string) error {
+	return DoDeleteWithContext(context.Background(), httpSession, method, path)
+}
+
+// DoDeleteWithContext is like DoDelete, but uses ctx to control cancellation of the HTTP request.
+func DoDeleteWithContext(ctx context.Context, httpSession *HttpSession, method string, path string) error {
 	pathUrl, err := url.Parse(path)
 	if err != nil {
 		return err
 	}
 
-	req := &http.Request{
-		Method: method,
-		URL:    pathUrl,
+	req, err := newRequest(ctx, method, pathUrl)
+	if err != nil {
+		return err
 	}
 
 	var responsePayload = new(any)
@@ -158,20 +186,48 @@ func DoDelete(httpSession *HttpSession, method string, path string) error {
 	return nil
 }
 
+// newRequest builds a bodyless request bound to ctx; the body (if any) is assigned later by DoRawJsonRequest.
+func newRequest(ctx context.Context, method string, pathUrl *url.URL) (*http.Request, error) {
+	if ctx == nil {
+		return nil, errors.New("nil Context")
+	}
+	req := &http.Request{
+		Method: method,
+		URL:    pathUrl,
+	}
+	return req.WithContext(ctx), nil
+}
+
 func Get[TResponse any](httpSession *HttpSession, url string) (*TResponse, error) {
-	return DoRequest[TResponse](httpSession, http.MethodGet, url, nil)
+	return GetWithContext[TResponse](context.Background(), httpSession, url)
+}
+
+func GetWithContext[TResponse any](ctx context.Context, httpSession *HttpSession, url string) (*TResponse, error) {
+	return DoRequestWithContext[TResponse](ctx, httpSession, http.MethodGet, url, nil)
 }
 
 func Post[TResponse any](httpSession *HttpSession, url string, body any) (*TResponse, error) {
-	return DoRequest[TResponse](httpSession, http.MethodPost, url, body)
+	return PostWithContext[TResponse](context.Background(), httpSession, url, body)
+}
+
+func PostWithContext[TResponse any](ctx context.Context, httpSession *HttpSession, url string, body any) (*TResponse, error) {
+	return DoRequestWithContext[TResponse](ctx, httpSession, http.MethodPost, url, body)
 }
 
 func Put[TResponse any](httpSession *HttpSession, url string, body any) (*TResponse, error) {
-	return DoRequest[TResponse](httpSession, http.MethodPut, url, body)
+	return PutWithContext[TResponse](context.Background(), httpSession, url, body)
+}
+
+func PutWithContext[TResponse any](ctx context.Context, httpSession *HttpSession, url string, body any) (*TResponse, error) {
+	return DoRequestWithContext[TResponse](ctx, httpSession, http.MethodPut, url, body)
 }
 
 func Delete(httpSession *HttpSession, url string) error {
-	return DoDelete(httpSession, http.MethodDelete, url)
+	return DeleteWithContext(context.Background(), httpSession, url)
+}
+
+func DeleteWithContext(ctx context.Context, httpSession *HttpSession, url string) error {
+	return DoDeleteWithContext(ctx, httpSession, http.MethodDelete, url)
 }
 
 // CloseResponse closes a response body; If you use DoRequest, and not one of the higher level helpers like Get or Post,
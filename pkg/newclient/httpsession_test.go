@@ -0,0 +1,75 @@
+package newclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+type testResource struct {
+	ID string `json:"Id"`
+}
+
+func newTestHttpSession(t *testing.T, handler http.HandlerFunc) *HttpSession {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return &HttpSession{
+		HttpClient: server.Client(),
+		BaseURL:    baseURL,
+	}
+}
+
+func TestGetWithContext(t *testing.T) {
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/things/Things-1", r.URL.Path)
+		_, _ = w.Write([]byte(`{"Id":"Things-1"}`))
+	})
+
+	resource, err := GetWithContext[testResource](context.Background(), httpSession, "/api/things/Things-1")
+	require.NoError(t, err)
+	require.Equal(t, "Things-1", resource.ID)
+}
+
+func TestGetWithCancelledContext(t *testing.T) {
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not reach the server")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resource, err := GetWithContext[testResource](ctx, httpSession, "/api/things/Things-1")
+	require.Nil(t, resource)
+	require.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestGetReturnsTypedAPIErrors(t *testing.T) {
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/things/Things-1":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"ErrorMessage":"The resource 'Things-1' was not found."}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>Bad Gateway</html>`))
+		}
+	})
+
+	_, err := Get[testResource](httpSession, "/api/things/Things-1")
+	var notFoundError *core.NotFoundError
+	require.ErrorAs(t, err, &notFoundError)
+	require.Equal(t, "The resource 'Things-1' was not found.", notFoundError.ErrorMessage)
+
+	_, err = Get[testResource](httpSession, "/api/things/Things-2")
+	require.ErrorIs(t, err, core.ErrServerError)
+}
@@ -0,0 +1,96 @@
+package newclient
+
+import (
+	"context"
+	"iter"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+// IterateByQuery returns an iterator over the resources matching the criteria
+// defined by its input query parameter. Pages are requested lazily by following
+// the PageNext link of each page, so only one page is held in memory at a time.
+// The take parameter of the query, if set, controls the page size. Breaking out
+// of the iteration early stops any further pages from being requested.
+//
+// If an error occurs, it is yielded with a nil resource and the iteration ends.
+func IterateByQuery[TResource any](client Client, template string, spaceID string, query any) iter.Seq2[*TResource, error] {
+	return IterateByQueryWithContext[TResource](context.Background(), client, template, spaceID, query)
+}
+
+// IterateByQueryWithContext is like IterateByQuery, but uses ctx to control cancellation of the HTTP requests.
+func IterateByQueryWithContext[TResource any](ctx context.Context, client Client, template string, spaceID string, query any) iter.Seq2[*TResource, error] {
+	return func(yield func(*TResource, error) bool) {
+		spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		values, _ := uritemplates.Struct2map(query)
+		if values == nil {
+			values = map[string]any{}
+		}
+		values["spaceId"] = spaceID
+		path, err := client.URITemplateCache().Expand(template, values)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		iteratePages[TResource](ctx, client, path, yield)
+	}
+}
+
+// IterateByPath returns an iterator over the resources of the paged collection
+// found at path, and of every page linked from it via PageNext. Pages are
+// requested lazily as the iteration proceeds.
+//
+// If an error occurs, it is yielded with a nil resource and the iteration ends.
+func IterateByPath[TResource any](client Client, path string) iter.Seq2[*TResource, error] {
+	return IterateByPathWithContext[TResource](context.Background(), client, path)
+}
+
+// IterateByPathWithContext is like IterateByPath, but uses ctx to control cancellation of the HTTP requests.
+func IterateByPathWithContext[TResource any](ctx context.Context, client Client, path string) iter.Seq2[*TResource, error] {
+	return func(yield func(*TResource, error) bool) {
+		iteratePages[TResource](ctx, client, path, yield)
+	}
+}
+
+func iteratePages[TResource any](ctx context.Context, client Client, path string, yield func(*TResource, error) bool) {
+	for {
+		res, err := GetWithContext[resources.Resources[*TResource]](ctx, client.HttpSession(), path)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for _, item := range res.Items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if res.Links.PageNext == "" {
+			return
+		}
+		path, err = client.URITemplateCache().Expand(res.Links.PageNext, map[string]any{})
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+	}
+}
+
+// Collect drains an iterator returned by IterateByQuery or IterateByPath into
+// a slice. If an error occurs, it returns nil and the error.
+func Collect[TResource any](seq iter.Seq2[TResource, error]) ([]TResource, error) {
+	items := make([]TResource, 0)
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
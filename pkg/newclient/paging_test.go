@@ -0,0 +1,85 @@
+package newclient
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testThingsTemplate = "/api/{spaceId}/things{?skip,take,partialName}"
+
+type testThingsQuery struct {
+	PartialName string `uri:"partialName,omitempty"`
+	Skip        int    `uri:"skip,omitempty"`
+	Take        int    `uri:"take,omitempty"`
+}
+
+// newPagedThingsHandler serves total things in pages, linking each page to the next.
+func newPagedThingsHandler(t *testing.T, total int, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		require.Equal(t, "/api/Spaces-1/things", r.URL.Path)
+		require.Equal(t, "Thing", r.URL.Query().Get("partialName"))
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		take, err := strconv.Atoi(r.URL.Query().Get("take"))
+		require.NoError(t, err)
+
+		items := ""
+		for i := skip; i < skip+take && i < total; i++ {
+			if items != "" {
+				items += ","
+			}
+			items += fmt.Sprintf(`{"Id":"Things-%d"}`, i+1)
+		}
+		next := ""
+		if skip+take < total {
+			next = fmt.Sprintf("/api/Spaces-1/things?skip=%d&take=%d&partialName=Thing", skip+take, take)
+		}
+		_, _ = fmt.Fprintf(w, `{"Items":[%s],"ItemsPerPage":%d,"TotalResults":%d,"Links":{"Page.Next":%q}}`, items, take, total, next)
+	}
+}
+
+func TestIterateByQueryFollowsPageNext(t *testing.T) {
+	var requests int32
+	client := NewClientS(newTestHttpSession(t, newPagedThingsHandler(t, 5, &requests)), "Spaces-1")
+
+	var ids []string
+	for thing, err := range IterateByQuery[testResource](client, testThingsTemplate, "", testThingsQuery{PartialName: "Thing", Take: 2}) {
+		require.NoError(t, err)
+		ids = append(ids, thing.ID)
+	}
+	require.Equal(t, []string{"Things-1", "Things-2", "Things-3", "Things-4", "Things-5"}, ids)
+	require.Equal(t, int32(3), requests)
+}
+
+func TestIterateByQueryStopsEarly(t *testing.T) {
+	var requests int32
+	client := NewClientS(newTestHttpSession(t, newPagedThingsHandler(t, 100, &requests)), "Spaces-1")
+
+	count := 0
+	for _, err := range IterateByQuery[testResource](client, testThingsTemplate, "", testThingsQuery{PartialName: "Thing", Take: 10}) {
+		require.NoError(t, err)
+		count++
+		if count == 15 {
+			break
+		}
+	}
+	require.Equal(t, 15, count)
+	require.Equal(t, int32(2), requests)
+}
+
+func TestIterateByQueryYieldsErrors(t *testing.T) {
+	client := NewClientS(newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"boom"}`))
+	}), "Spaces-1")
+
+	things, err := Collect(IterateByQuery[testResource](client, testThingsTemplate, "", testThingsQuery{}))
+	require.Error(t, err)
+	require.Nil(t, things)
+}
@@ -0,0 +1,214 @@
+package newclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// Doer performs HTTP requests. It is satisfied by *http.Client, and is structurally identical to sling.Doer
+// so that a retrying doer can be plugged into the legacy sling-based services.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// RetryAttempt describes a failed attempt which is about to be retried. It is passed to RetryPolicy.OnRetry.
+type RetryAttempt struct {
+	// Attempt is the 1-based number of the attempt which failed
+	Attempt int
+	// Request is the request which failed
+	Request *http.Request
+	// Response is the response to the failed attempt; it is nil if the attempt failed with a transport error.
+	// The body has already been closed.
+	Response *http.Response
+	// Err is the transport error for the failed attempt, if any
+	Err error
+	// Delay is how long the policy will wait before making the next attempt
+	Delay time.Duration
+}
+
+// RetryPolicy controls how failed HTTP requests are retried.
+//
+// Requests are retried when the transport returns an error (such as a connection reset), or when the server
+// responds with one of RetryableStatusCodes. Waits between attempts use exponential backoff with full jitter,
+// unless the server supplies a Retry-After header, in which case that is honoured instead.
+// By default, only idempotent requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first. Values less than 1 are treated as 1.
+	MaxAttempts int
+	// InitialBackoff is the upper bound of the wait before the first retry; it doubles with each subsequent retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts, including waits requested via Retry-After.
+	MaxBackoff time.Duration
+	// RetryableStatusCodes lists the HTTP status codes which should be retried.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent allows POST and PATCH requests to be retried. Only enable this if the server
+	// operations being called are safe to repeat.
+	RetryNonIdempotent bool
+	// OnRetry, if set, is called before waiting for each retry.
+	OnRetry func(attempt RetryAttempt)
+
+	// random source for jitter; overridable for tests
+	random func() float64
+}
+
+// NewRetryPolicy returns a retry policy with sensible defaults: three attempts, exponential backoff starting at
+// 500ms and capped at 30s, retrying 429, 502, 503 and 504 responses for idempotent requests only.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          defaultRetryMaxAttempts,
+		InitialBackoff:       defaultRetryInitialBackoff,
+		MaxBackoff:           defaultRetryMaxBackoff,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+// Do sends req using doer, retrying according to the policy. The request's context is honoured while waiting
+// between attempts. A nil policy performs exactly one attempt.
+func (p *RetryPolicy) Do(doer Doer, req *http.Request) (*http.Response, error) {
+	if p == nil || !p.canRetryRequest(req) {
+		return doer.Do(req)
+	}
+
+	maxAttempts := p.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := doer.Do(attemptReq)
+		if attempt >= maxAttempts || !p.shouldRetry(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt, resp)
+		if resp != nil {
+			CloseResponse(resp)
+		}
+		if p.OnRetry != nil {
+			p.OnRetry(RetryAttempt{
+				Attempt:  attempt,
+				Request:  req,
+				Response: resp,
+				Err:      err,
+				Delay:    delay,
+			})
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Doer wraps doer so that every request it performs is retried according to the policy.
+// The result can be passed to sling.Sling.Doer to apply the policy to the legacy services.
+func (p *RetryPolicy) Doer(doer Doer) Doer {
+	return &retryingDoer{doer: doer, policy: p}
+}
+
+func (p *RetryPolicy) canRetryRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the body can't be replayed, so a second attempt would send an empty payload
+		return false
+	}
+	return p.RetryNonIdempotent || isIdempotent(req.Method)
+}
+
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// a cancelled or expired context is the caller's decision, not a transient failure
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return p.capBackoff(retryAfter)
+		}
+	}
+
+	ceiling := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		if p.MaxBackoff > 0 && ceiling >= p.MaxBackoff {
+			break
+		}
+		ceiling *= 2
+	}
+
+	random := p.random
+	if random == nil {
+		random = rand.Float64
+	}
+	return p.capBackoff(time.Duration(random() * float64(ceiling)))
+}
+
+func (p *RetryPolicy) capBackoff(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return d
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+type retryingDoer struct {
+	doer   Doer
+	policy *RetryPolicy
+}
+
+func (d *retryingDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.policy.Do(d.doer, req)
+}
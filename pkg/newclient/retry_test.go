@@ -0,0 +1,129 @@
+package newclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryPolicy(onRetry func(RetryAttempt)) *RetryPolicy {
+	policy := NewRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	policy.OnRetry = onRetry
+	return policy
+}
+
+func TestRetryPolicyRetriesTransientStatusCodes(t *testing.T) {
+	var requests int32
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Id":"Things-1"}`))
+	})
+
+	var attempts []int
+	httpSession.RetryPolicy = newTestRetryPolicy(func(attempt RetryAttempt) {
+		attempts = append(attempts, attempt.Attempt)
+		require.Equal(t, http.StatusServiceUnavailable, attempt.Response.StatusCode)
+	})
+
+	resource, err := Get[testResource](httpSession, "/api/things/Things-1")
+	require.NoError(t, err)
+	require.Equal(t, "Things-1", resource.ID)
+	require.Equal(t, int32(3), requests)
+	require.Equal(t, []int{1, 2}, attempts)
+}
+
+func TestRetryPolicyGivesUpAfterMaxAttempts(t *testing.T) {
+	var requests int32
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	httpSession.RetryPolicy = newTestRetryPolicy(nil)
+
+	resp, err := httpSession.DoRawRequest(&http.Request{Method: http.MethodGet, URL: httpSession.BaseURL})
+	require.NoError(t, err)
+	CloseResponse(resp)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, int32(3), requests)
+}
+
+func TestRetryPolicySkipsNonIdempotentRequests(t *testing.T) {
+	var requests int32
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"unavailable"}`))
+	})
+	httpSession.RetryPolicy = newTestRetryPolicy(nil)
+
+	_, err := Post[testResource](httpSession, "/api/things", &testResource{ID: "Things-1"})
+	require.Error(t, err)
+	require.Equal(t, int32(1), requests)
+}
+
+func TestRetryPolicyReplaysRequestBody(t *testing.T) {
+	var requests int32
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		require.JSONEq(t, `{"Id":"Things-1"}`, string(body))
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write(body)
+	})
+	httpSession.RetryPolicy = newTestRetryPolicy(nil)
+	httpSession.RetryPolicy.RetryNonIdempotent = true
+
+	resource, err := Post[testResource](httpSession, "/api/things", &testResource{ID: "Things-1"})
+	require.NoError(t, err)
+	require.Equal(t, "Things-1", resource.ID)
+	require.Equal(t, int32(2), requests)
+}
+
+func TestRetryPolicyHonoursRetryAfter(t *testing.T) {
+	policy := newTestRetryPolicy(nil)
+	policy.MaxBackoff = time.Minute
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	require.Equal(t, 7*time.Second, policy.backoff(1, resp))
+
+	policy.MaxBackoff = 2 * time.Second
+	require.Equal(t, 2*time.Second, policy.backoff(1, resp))
+}
+
+func TestRetryPolicyBackoffIsExponential(t *testing.T) {
+	policy := NewRetryPolicy()
+	policy.random = func() float64 { return 1 }
+
+	require.Equal(t, 500*time.Millisecond, policy.backoff(1, nil))
+	require.Equal(t, time.Second, policy.backoff(2, nil))
+	require.Equal(t, 2*time.Second, policy.backoff(3, nil))
+	require.Equal(t, 30*time.Second, policy.backoff(100, nil))
+}
+
+func TestRetryPolicyStopsWhenContextIsCancelled(t *testing.T) {
+	httpSession := newTestHttpSession(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpSession.RetryPolicy = newTestRetryPolicy(func(attempt RetryAttempt) {
+		cancel()
+	})
+	httpSession.RetryPolicy.InitialBackoff = time.Minute
+	httpSession.RetryPolicy.MaxBackoff = time.Minute
+
+	_, err := GetWithContext[testResource](ctx, httpSession, "/api/things/Things-1")
+	require.ErrorIs(t, err, context.Canceled)
+}
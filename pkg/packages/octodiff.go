@@ -0,0 +1,361 @@
+package packages
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// The delta compression used by Octopus Server is provided by Octodiff
+// (https://github.com/OctopusDeploy/Octodiff). The server produces signatures
+// of packages it already has, and applies deltas computed against those
+// signatures to reconstruct new packages.
+
+const (
+	octodiffVersion      byte = 0x01
+	octodiffCopyCommand  byte = 0x60
+	octodiffDataCommand  byte = 0x80
+	octodiffHashName          = "SHA1"
+	octodiffChecksumName      = "Adler32"
+
+	// maxDeltaDataLength bounds the size of a single data command, so that
+	// unmatched regions of a package are streamed rather than buffered.
+	maxDeltaDataLength = 1 << 20
+)
+
+var (
+	octodiffSignatureHeader = []byte("OCTOSIG")
+	octodiffDeltaHeader     = []byte("OCTODELTA")
+	octodiffEndOfMetadata   = []byte(">>>")
+
+	// errUnsupportedSignature is returned for signatures that are not in the
+	// Octodiff format, or use algorithms other than SHA1 and Adler32.
+	errUnsupportedSignature = errors.New("the package signature is not in a supported format")
+)
+
+// signatureChunk describes one block of the base package.
+type signatureChunk struct {
+	offset   int64
+	length   int
+	checksum uint32
+	hash     []byte
+}
+
+// signature is a parsed Octodiff signature of a base package.
+type signature struct {
+	chunks []signatureChunk
+}
+
+// parseSignature reads an Octodiff signature.
+func parseSignature(data []byte) (*signature, error) {
+	r := bytes.NewReader(data)
+
+	header := make([]byte, len(octodiffSignatureHeader)+1)
+	if _, err := io.ReadFull(r, header); err != nil || !bytes.Equal(header[:len(octodiffSignatureHeader)], octodiffSignatureHeader) || header[len(header)-1] != octodiffVersion {
+		return nil, errUnsupportedSignature
+	}
+	hashName, err := readDotNetString(r)
+	if err != nil || hashName != octodiffHashName {
+		return nil, errUnsupportedSignature
+	}
+	checksumName, err := readDotNetString(r)
+	if err != nil || checksumName != octodiffChecksumName {
+		return nil, errUnsupportedSignature
+	}
+	endOfMetadata := make([]byte, len(octodiffEndOfMetadata))
+	if _, err := io.ReadFull(r, endOfMetadata); err != nil || !bytes.Equal(endOfMetadata, octodiffEndOfMetadata) {
+		return nil, errUnsupportedSignature
+	}
+
+	const chunkSize = 2 + 4 + sha1.Size
+	if r.Len()%chunkSize != 0 {
+		return nil, errUnsupportedSignature
+	}
+
+	s := &signature{chunks: make([]signatureChunk, 0, r.Len()/chunkSize)}
+	var offset int64
+	entry := make([]byte, chunkSize)
+	for r.Len() > 0 {
+		if _, err := io.ReadFull(r, entry); err != nil {
+			return nil, errUnsupportedSignature
+		}
+		chunk := signatureChunk{
+			offset:   offset,
+			length:   int(binary.LittleEndian.Uint16(entry[0:2])),
+			checksum: binary.LittleEndian.Uint32(entry[2:6]),
+			hash:     append([]byte{}, entry[6:]...),
+		}
+		if chunk.length == 0 {
+			return nil, errUnsupportedSignature
+		}
+		s.chunks = append(s.chunks, chunk)
+		offset += int64(chunk.length)
+	}
+	return s, nil
+}
+
+// readDotNetString reads a string written by .NET's BinaryWriter, which
+// prefixes it with its length encoded as a 7-bit integer.
+func readDotNetString(r *bytes.Reader) (string, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if length > uint64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+	value := make([]byte, length)
+	if _, err := io.ReadFull(r, value); err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func writeDotNetString(w io.Writer, value string) error {
+	length := binary.AppendUvarint(nil, uint64(len(value)))
+	_, err := w.Write(append(length, value...))
+	return err
+}
+
+// adler32 computes Octodiff's Adler32 rolling checksum, which, unlike the
+// standard algorithm, wraps at 16 bits rather than using a prime modulus.
+func adler32(block []byte) uint32 {
+	var a, b uint16 = 1, 0
+	for _, z := range block {
+		a += uint16(z)
+		b += a
+	}
+	return uint32(b)<<16 | uint32(a)
+}
+
+// rotateAdler32 updates a checksum computed by adler32 as the block slides
+// forward by one byte.
+func rotateAdler32(checksum uint32, remove byte, add byte, blockLength int) uint32 {
+	a := uint16(checksum)
+	b := uint16(checksum >> 16)
+	a = a - uint16(remove) + uint16(add)
+	b = b - uint16(blockLength*int(remove)) + a - 1
+	return uint32(b)<<16 | uint32(a)
+}
+
+// deltaWriter writes Octodiff delta commands, merging adjacent copies.
+type deltaWriter struct {
+	w io.Writer
+
+	copyOffset int64
+	copyLength int64
+}
+
+func (d *deltaWriter) writeMetadata(hash []byte) error {
+	var b bytes.Buffer
+	b.Write(octodiffDeltaHeader)
+	b.WriteByte(octodiffVersion)
+	if err := writeDotNetString(&b, octodiffHashName); err != nil {
+		return err
+	}
+	_ = binary.Write(&b, binary.LittleEndian, int32(len(hash)))
+	b.Write(hash)
+	b.Write(octodiffEndOfMetadata)
+	_, err := d.w.Write(b.Bytes())
+	return err
+}
+
+func (d *deltaWriter) copy(chunk signatureChunk) error {
+	if d.copyLength > 0 && d.copyOffset+d.copyLength == chunk.offset {
+		d.copyLength += int64(chunk.length)
+		return nil
+	}
+	if err := d.flush(); err != nil {
+		return err
+	}
+	d.copyOffset = chunk.offset
+	d.copyLength = int64(chunk.length)
+	return nil
+}
+
+func (d *deltaWriter) data(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	if err := d.flush(); err != nil {
+		return err
+	}
+	command := make([]byte, 9)
+	command[0] = octodiffDataCommand
+	binary.LittleEndian.PutUint64(command[1:], uint64(len(data)))
+	if _, err := d.w.Write(command); err != nil {
+		return err
+	}
+	_, err := d.w.Write(data)
+	return err
+}
+
+// flush writes any pending copy command.
+func (d *deltaWriter) flush() error {
+	if d.copyLength == 0 {
+		return nil
+	}
+	command := make([]byte, 17)
+	command[0] = octodiffCopyCommand
+	binary.LittleEndian.PutUint64(command[1:], uint64(d.copyOffset))
+	binary.LittleEndian.PutUint64(command[9:], uint64(d.copyLength))
+	d.copyLength = 0
+	_, err := d.w.Write(command)
+	return err
+}
+
+// writeDelta writes an Octodiff delta which reconstructs the new package from
+// the base package described by s. The new package is read twice: once to
+// compute the hash the server uses to verify the result, and once to find the
+// blocks it shares with the base package.
+func writeDelta(w io.Writer, s *signature, newPackage io.ReadSeeker) error {
+	hash := sha1.New()
+	if _, err := io.Copy(hash, newPackage); err != nil {
+		return err
+	}
+	if _, err := newPackage.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriterSize(w, 64*1024)
+	d := &deltaWriter{w: bw}
+	if err := d.writeMetadata(hash.Sum(nil)); err != nil {
+		return err
+	}
+	if err := matchBlocks(d, s, bufio.NewReaderSize(newPackage, 1<<20)); err != nil {
+		return err
+	}
+	if err := d.flush(); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// matchBlocks slides a window the size of the base package's blocks over the
+// new package, copying blocks whose checksum and hash match and sending the
+// bytes in between as data.
+func matchBlocks(d *deltaWriter, s *signature, r *bufio.Reader) error {
+	if len(s.chunks) == 0 {
+		return sendRemaining(d, r)
+	}
+
+	// every block except perhaps the last has the same length
+	blockLength := s.chunks[0].length
+	blocks := map[uint32][]signatureChunk{}
+	for _, chunk := range s.chunks {
+		if chunk.length == blockLength {
+			blocks[chunk.checksum] = append(blocks[chunk.checksum], chunk)
+		}
+	}
+	tail := s.chunks[len(s.chunks)-1]
+
+	// buf holds unmatched bytes waiting to be sent, followed by the window
+	buf := make([]byte, 0, maxDeltaDataLength+blockLength)
+	pending := 0
+
+	fill := func() (bool, error) {
+		buf = buf[:blockLength]
+		n, err := io.ReadFull(r, buf)
+		buf = buf[:n]
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	full, err := fill()
+	if err != nil {
+		return err
+	}
+	if !full {
+		return sendTail(d, tail, buf)
+	}
+	checksum := adler32(buf)
+
+	for {
+		window := buf[pending:]
+		if chunk, ok := findBlock(blocks[checksum], window); ok {
+			if err := d.data(buf[:pending]); err != nil {
+				return err
+			}
+			if err := d.copy(chunk); err != nil {
+				return err
+			}
+			pending = 0
+			if full, err = fill(); err != nil {
+				return err
+			}
+			if !full {
+				return sendTail(d, tail, buf)
+			}
+			checksum = adler32(buf)
+			continue
+		}
+
+		next, err := r.ReadByte()
+		if err == io.EOF {
+			if err := d.data(buf[:pending]); err != nil {
+				return err
+			}
+			return sendTail(d, tail, buf[pending:])
+		}
+		if err != nil {
+			return err
+		}
+		checksum = rotateAdler32(checksum, buf[pending], next, blockLength)
+		buf = append(buf, next)
+		pending++
+
+		if pending >= maxDeltaDataLength {
+			if err := d.data(buf[:pending]); err != nil {
+				return err
+			}
+			buf = buf[:copy(buf, buf[pending:])]
+			pending = 0
+		}
+	}
+}
+
+func findBlock(candidates []signatureChunk, window []byte) (signatureChunk, bool) {
+	if len(candidates) == 0 {
+		return signatureChunk{}, false
+	}
+	hash := sha1.Sum(window)
+	for _, chunk := range candidates {
+		if bytes.Equal(chunk.hash, hash[:]) {
+			return chunk, true
+		}
+	}
+	return signatureChunk{}, false
+}
+
+// sendTail sends the final bytes of the new package, copying the last block
+// of the base package if they match it.
+func sendTail(d *deltaWriter, tail signatureChunk, remaining []byte) error {
+	if len(remaining) == tail.length && adler32(remaining) == tail.checksum {
+		if _, ok := findBlock([]signatureChunk{tail}, remaining); ok {
+			return d.copy(tail)
+		}
+	}
+	return d.data(remaining)
+}
+
+// sendRemaining sends the rest of the new package as data.
+func sendRemaining(d *deltaWriter, r io.Reader) error {
+	buf := make([]byte, maxDeltaDataLength)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if dataErr := d.data(buf[:n]); dataErr != nil {
+			return dataErr
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
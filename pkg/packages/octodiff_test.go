@@ -0,0 +1,156 @@
+package packages
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// buildSignature creates an Octodiff signature in the way Octopus Server does.
+func buildSignature(t *testing.T, base []byte, blockLength int) []byte {
+	var b bytes.Buffer
+	b.Write(octodiffSignatureHeader)
+	b.WriteByte(octodiffVersion)
+	require.NoError(t, writeDotNetString(&b, octodiffHashName))
+	require.NoError(t, writeDotNetString(&b, octodiffChecksumName))
+	b.Write(octodiffEndOfMetadata)
+	for offset := 0; offset < len(base); offset += blockLength {
+		block := base[offset:min(offset+blockLength, len(base))]
+		hash := sha1.Sum(block)
+		_ = binary.Write(&b, binary.LittleEndian, uint16(len(block)))
+		_ = binary.Write(&b, binary.LittleEndian, adler32(block))
+		b.Write(hash[:])
+	}
+	return b.Bytes()
+}
+
+// applyDelta reconstructs a package from its base in the way Octopus Server
+// does, and returns the number of bytes sent as data.
+func applyDelta(t *testing.T, base []byte, delta []byte) ([]byte, int) {
+	r := bytes.NewReader(delta)
+
+	header := make([]byte, len(octodiffDeltaHeader)+1)
+	_, err := io.ReadFull(r, header)
+	require.NoError(t, err)
+	require.Equal(t, append(octodiffDeltaHeader, octodiffVersion), header)
+	hashName, err := readDotNetString(r)
+	require.NoError(t, err)
+	require.Equal(t, octodiffHashName, hashName)
+	var hashLength int32
+	require.NoError(t, binary.Read(r, binary.LittleEndian, &hashLength))
+	expectedHash := make([]byte, hashLength)
+	_, err = io.ReadFull(r, expectedHash)
+	require.NoError(t, err)
+	endOfMetadata := make([]byte, 3)
+	_, err = io.ReadFull(r, endOfMetadata)
+	require.NoError(t, err)
+	require.Equal(t, octodiffEndOfMetadata, endOfMetadata)
+
+	var result []byte
+	dataLength := 0
+	for r.Len() > 0 {
+		command, err := r.ReadByte()
+		require.NoError(t, err)
+		switch command {
+		case octodiffCopyCommand:
+			var offset, length int64
+			require.NoError(t, binary.Read(r, binary.LittleEndian, &offset))
+			require.NoError(t, binary.Read(r, binary.LittleEndian, &length))
+			result = append(result, base[offset:offset+length]...)
+		case octodiffDataCommand:
+			var length int64
+			require.NoError(t, binary.Read(r, binary.LittleEndian, &length))
+			data := make([]byte, length)
+			_, err := io.ReadFull(r, data)
+			require.NoError(t, err)
+			result = append(result, data...)
+			dataLength += len(data)
+		default:
+			t.Fatalf("unexpected delta command %#x", command)
+		}
+	}
+
+	hash := sha1.Sum(result)
+	require.Equal(t, expectedHash, hash[:])
+	return result, dataLength
+}
+
+func TestRotateAdler32MatchesAdler32(t *testing.T) {
+	data := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	const blockLength = 2048
+	checksum := adler32(data[:blockLength])
+	for i := 1; i+blockLength <= len(data); i++ {
+		checksum = rotateAdler32(checksum, data[i-1], data[i+blockLength-1], blockLength)
+		require.Equal(t, adler32(data[i:i+blockLength]), checksum, "offset %d", i)
+	}
+}
+
+func TestWriteDelta(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	base := make([]byte, 200*1024+123)
+	random.Read(base)
+
+	// insert, change and remove a few regions of the base package
+	changed := append([]byte{}, base[:10000]...)
+	changed = append(changed, []byte("inserted bytes")...)
+	changed = append(changed, base[10000:50000]...)
+	changed = append(changed, bytes.Repeat([]byte{0xff}, 3000)...)
+	changed = append(changed, base[60000:]...)
+
+	large := make([]byte, 3*maxDeltaDataLength+5)
+	random.Read(large)
+
+	testCases := []struct {
+		name       string
+		base       []byte
+		newPackage []byte
+		maxData    int
+	}{
+		{"Identical", base, base, 0},
+		{"Changed", base, changed, 4 * 2048},
+		{"Unrelated", base[:5000], changed, len(changed)},
+		{"EmptyBase", nil, changed, len(changed)},
+		{"LargeUnrelated", base, large, len(large)},
+		{"EmptyPackage", base, nil, 0},
+		{"ShorterThanBlock", base, base[:100], 100},
+		{"MatchingTail", base[:2048*3+17], append([]byte("x"), base[:2048*3+17]...), 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := parseSignature(buildSignature(t, tc.base, 2048))
+			require.NoError(t, err)
+
+			var delta bytes.Buffer
+			require.NoError(t, writeDelta(&delta, s, bytes.NewReader(tc.newPackage)))
+
+			result, dataLength := applyDelta(t, tc.base, delta.Bytes())
+			require.Equal(t, len(tc.newPackage), len(result))
+			require.True(t, bytes.Equal(tc.newPackage, result))
+			require.LessOrEqual(t, dataLength, tc.maxData)
+		})
+	}
+}
+
+func TestParseSignatureRejectsUnsupportedSignatures(t *testing.T) {
+	valid := buildSignature(t, []byte("package contents"), 2048)
+
+	_, err := parseSignature(valid)
+	require.NoError(t, err)
+
+	_, err = parseSignature([]byte("not a signature"))
+	require.ErrorIs(t, err, errUnsupportedSignature)
+
+	_, err = parseSignature(valid[:len(valid)-1])
+	require.ErrorIs(t, err, errUnsupportedSignature)
+
+	adler32V2 := bytes.Replace(valid, []byte("\x07Adler32"), []byte("\x09Adler32V2"), 1)
+	_, err = parseSignature(adler32V2)
+	require.ErrorIs(t, err, errUnsupportedSignature)
+}
@@ -1,9 +1,11 @@
 package packages
 
-type OverwriteMode string
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+
+type OverwriteMode = core.OverwriteMode
 
 const (
-	OverwriteModeFailIfExists      = OverwriteMode("FailIfExists")
-	OverwriteModeIgnoreIfExists    = OverwriteMode("IgnoreIfExists")
-	OverwriteModeOverwriteExisting = OverwriteMode("OverwriteExisting")
+	OverwriteModeFailIfExists      = core.OverwriteModeFailIfExists
+	OverwriteModeIgnoreIfExists    = core.OverwriteModeIgnoreIfExists
+	OverwriteModeOverwriteExisting = core.OverwriteModeOverwriteExisting
 )
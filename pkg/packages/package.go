@@ -11,9 +11,11 @@ type Package struct {
 	Description      string                             `json:"Description,omitempty"`
 	FeedID           string                             `json:"FeedId,omitempty"`
 	FileExtension    string                             `json:"FileExtension,omitempty"`
+	Hash             string                             `json:"Hash,omitempty"`
 	NuGetFeedID      string                             `json:"NuGetFeedId,omitempty"`
 	NuGetPackageID   string                             `json:"NuGetPackageId,omitempty"`
 	PackageID        string                             `json:"PackageId,omitempty"`
+	PackageSizeBytes int64                              `json:"PackageSizeBytes,omitempty"`
 	BuildInformation *buildinformation.BuildInformation `json:"PackageVersionBuildInformation,omitempty"`
 	Published        time.Time                          `json:"ReleaseNotes,omitempty"`
 	Summary          string                             `json:"Summary,omitempty"`
@@ -0,0 +1,10 @@
+package packages
+
+// DownloadOptions controls how a package is downloaded by Download.
+type DownloadOptions struct {
+	// Attempts is the number of times to request the package before giving
+	// up. If a download is interrupted, it is resumed from where it stopped
+	// with an HTTP range request. Only network failures and server errors
+	// are retried. Zero means one attempt.
+	Attempts int
+}
@@ -1,6 +1,7 @@
 package packages
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
@@ -100,6 +101,11 @@ func (s *PackageService) Update(octopusPackage *Package) (*Package, error) {
 // - reader: io.Reader which provides the binary file data to upload
 // - overwriteMode: Instructs the server what to do in the case that the package already exists.
 func Upload(client newclient.Client, spaceID string, fileName string, reader io.Reader, overwriteMode OverwriteMode) (*PackageUploadResponse, bool, error) {
+	return UploadWithContext(context.Background(), client, spaceID, fileName, reader, overwriteMode)
+}
+
+// UploadWithContext is like Upload, but uses ctx to control cancellation of the HTTP requests.
+func UploadWithContext(ctx context.Context, client newclient.Client, spaceID string, fileName string, reader io.Reader, overwriteMode OverwriteMode) (*PackageUploadResponse, bool, error) {
 	if client == nil {
 		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("client")
 	}
@@ -113,8 +119,6 @@ func Upload(client newclient.Client, spaceID string, fileName string, reader io.
 		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("reader")
 	}
 
-	multipartWriter := NewMultipartFileStreamingReader(fileName, reader)
-
 	params := map[string]any{
 		"spaceId": spaceID,
 	}
@@ -127,13 +131,21 @@ func Upload(client newclient.Client, spaceID string, fileName string, reader io.
 		return nil, false, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, expandedUri, multipartWriter)
+	return postPackageWithContext(ctx, client, expandedUri, fileName, reader)
+}
+
+// postPackageWithContext streams the contents of reader to path as a multipart
+// form, and reports whether the server created a new package.
+func postPackageWithContext(ctx context.Context, client newclient.Client, path string, fileName string, reader io.Reader) (*PackageUploadResponse, bool, error) {
+	multipartWriter := NewMultipartFileStreamingReader(fileName, reader)
+
+	req, err := http.NewRequest(http.MethodPost, path, multipartWriter)
 	if err != nil {
 		return nil, false, err
 	}
 	req.Header.Add("Content-Type", multipartWriter.FormDataContentType())
 
-	resp, err := client.HttpSession().DoRawRequest(req)
+	resp, err := client.HttpSession().DoRawRequestWithContext(ctx, req)
 	if err != nil {
 		return nil, false, err
 	}
@@ -151,17 +163,21 @@ func Upload(client newclient.Client, spaceID string, fileName string, reader io.
 		return outputResponseBody, createdNewFile, nil
 	} else {
 		outputResponseError := new(core.APIError)
-		err = bodyDecoder.Decode(outputResponseError)
-		if err != nil {
-			return nil, false, err
+		if err := bodyDecoder.Decode(outputResponseError); err != nil {
+			return nil, false, core.NewAPIError(resp, nil)
 		}
-		return nil, false, outputResponseError
+		return nil, false, core.NewAPIError(resp, outputResponseError)
 	}
 }
 
 // List returns a list of packages from the server, in a standard Octopus paginated result structure.
 // If you don't specify --limit the server will use a default limit (typically 30)
 func List(client newclient.Client, spaceID string, filter string, limit int) (*resources.Resources[*Package], error) {
+	return ListWithContext(context.Background(), client, spaceID, filter, limit)
+}
+
+// ListWithContext is like List, but uses ctx to control cancellation of the HTTP requests.
+func ListWithContext(ctx context.Context, client newclient.Client, spaceID string, filter string, limit int) (*resources.Resources[*Package], error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -180,5 +196,5 @@ func List(client newclient.Client, spaceID string, filter string, limit int) (*r
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[resources.Resources[*Package]](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[resources.Resources[*Package]](ctx, client.HttpSession(), expandedUri)
 }
@@ -0,0 +1,261 @@
+package packages
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+// packageFileExtensions are the archive formats accepted by the built-in
+// feed, longest first so that ".tar.gz" is preferred over ".gz".
+var packageFileExtensions = []string{".tar.bz2", ".tar.gz", ".nupkg", ".tar", ".tgz", ".zip", ".jar", ".war", ".ear", ".rar"}
+
+var packageFileNamePattern = regexp.MustCompile(`^(.+?)\.(\d+(?:\.\d+)*(?:[-+].*)?)$`)
+
+// GetDeltaSignature returns the signature of the version of a package in the
+// built-in feed that matches the input package ID and version.
+func GetDeltaSignature(client newclient.Client, spaceID string, packageID string, version string) (*PackageSignatureResponse, error) {
+	return GetDeltaSignatureWithContext(context.Background(), client, spaceID, packageID, version)
+}
+
+// GetDeltaSignatureWithContext is like GetDeltaSignature, but uses ctx to control cancellation of the HTTP requests.
+func GetDeltaSignatureWithContext(ctx context.Context, client newclient.Client, spaceID string, packageID string, version string) (*PackageSignatureResponse, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if internal.IsEmpty(packageID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("packageID")
+	}
+	if internal.IsEmpty(version) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("version")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := client.URITemplateCache().Expand(uritemplates.PackageDeltaSignature, map[string]any{
+		"spaceId":   spaceID,
+		"packageId": packageID,
+		"version":   version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[PackageSignatureResponse](ctx, client.HttpSession(), path)
+}
+
+// UploadDelta uploads a package to the octopus server's builtin package feed,
+// sending only the differences between it and the nearest earlier version of
+// the package in the feed. The package ID and version are read from fileName,
+// which must be of the form "<packageId>.<version>.<extension>".
+//
+// If the feed has no earlier version of the package, or its signature cannot
+// be used, the whole package is uploaded as it would be by Upload. The package
+// is read more than once, so it must be provided as an io.ReadSeeker
+// positioned at its start.
+func UploadDelta(client newclient.Client, spaceID string, fileName string, reader io.ReadSeeker, overwriteMode OverwriteMode) (*PackageUploadResponse, bool, error) {
+	return UploadDeltaWithContext(context.Background(), client, spaceID, fileName, reader, overwriteMode)
+}
+
+// UploadDeltaWithContext is like UploadDelta, but uses ctx to control cancellation of the HTTP requests.
+func UploadDeltaWithContext(ctx context.Context, client newclient.Client, spaceID string, fileName string, reader io.ReadSeeker, overwriteMode OverwriteMode) (*PackageUploadResponse, bool, error) {
+	if client == nil {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if spaceID == "" {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
+	}
+	if fileName == "" {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("fileName")
+	}
+	if reader == nil {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("reader")
+	}
+
+	packageID, version, ok := parsePackageFileName(fileName)
+	if !ok {
+		return UploadWithContext(ctx, client, spaceID, fileName, reader, overwriteMode)
+	}
+
+	baseVersion, err := findBaseVersion(ctx, client, spaceID, packageID, version)
+	if err != nil {
+		return nil, false, err
+	}
+	if baseVersion == "" {
+		return UploadWithContext(ctx, client, spaceID, fileName, reader, overwriteMode)
+	}
+
+	signatureResponse, err := GetDeltaSignatureWithContext(ctx, client, spaceID, packageID, baseVersion)
+	if errors.Is(err, core.ErrNotFound) {
+		return UploadWithContext(ctx, client, spaceID, fileName, reader, overwriteMode)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	signature, err := parseSignature(signatureResponse.Signature)
+	if err != nil {
+		return UploadWithContext(ctx, client, spaceID, fileName, reader, overwriteMode)
+	}
+
+	params := map[string]any{
+		"spaceId":     spaceID,
+		"packageId":   packageID,
+		"baseVersion": baseVersion,
+	}
+	if overwriteMode != "" {
+		params["overwriteMode"] = overwriteMode
+	}
+	expandedUri, err := client.URITemplateCache().Expand(uritemplates.PackageDeltaUpload, params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the delta is computed while it is uploaded, so that it is never held in memory
+	deltaReader, deltaWriter := io.Pipe()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		deltaWriter.CloseWithError(writeDelta(deltaWriter, signature, &stoppableReadSeeker{ReadSeeker: reader, stop: stop}))
+	}()
+
+	response, created, err := postPackageWithContext(ctx, client, expandedUri, fileName, deltaReader)
+
+	// the upload can end before the delta is written, such as when the server
+	// rejects it. The caller may close or reuse reader once this returns, so
+	// the goroutine is stopped and waited for.
+	close(stop)
+	deltaReader.CloseWithError(err)
+	<-done
+
+	return response, created, err
+}
+
+// errDeltaUploadEnded is returned by reads of the package once the delta
+// upload has ended.
+var errDeltaUploadEnded = errors.New("the delta upload has ended")
+
+// stoppableReadSeeker fails every read once stop is closed.
+type stoppableReadSeeker struct {
+	io.ReadSeeker
+	stop <-chan struct{}
+}
+
+func (r *stoppableReadSeeker) Read(p []byte) (int, error) {
+	select {
+	case <-r.stop:
+		return 0, errDeltaUploadEnded
+	default:
+		return r.ReadSeeker.Read(p)
+	}
+}
+
+// findBaseVersion returns the highest version of a package in the built-in
+// feed which is lower than version, or an empty string if there is none.
+func findBaseVersion(ctx context.Context, client newclient.Client, spaceID string, packageID string, version string) (string, error) {
+	query := PackagesQuery{NuGetPackageID: packageID}
+
+	baseVersion := ""
+	for existing, err := range newclient.IterateByQueryWithContext[Package](ctx, client, uritemplates.Packages, spaceID, query) {
+		if err != nil {
+			return "", err
+		}
+		if !strings.EqualFold(existing.PackageID, packageID) || compareVersions(existing.Version, version) >= 0 {
+			continue
+		}
+		if baseVersion == "" || compareVersions(existing.Version, baseVersion) > 0 {
+			baseVersion = existing.Version
+		}
+	}
+	return baseVersion, nil
+}
+
+// parsePackageFileName splits a file name of the form
+// "<packageId>.<version>.<extension>" into its package ID and version.
+func parsePackageFileName(fileName string) (string, string, bool) {
+	for _, extension := range packageFileExtensions {
+		if len(fileName) > len(extension) && strings.EqualFold(fileName[len(fileName)-len(extension):], extension) {
+			matches := packageFileNamePattern.FindStringSubmatch(fileName[:len(fileName)-len(extension)])
+			if matches == nil {
+				return "", "", false
+			}
+			return matches[1], matches[2], true
+		}
+	}
+	return "", "", false
+}
+
+// compareVersions orders package versions by their numeric components, then
+// by their pre-release labels following the rules of semantic versioning.
+// Build metadata is ignored.
+func compareVersions(a string, b string) int {
+	aRelease, aPreRelease := splitVersion(a)
+	bRelease, bPreRelease := splitVersion(b)
+
+	aParts := strings.Split(aRelease, ".")
+	bParts := strings.Split(bRelease, ".")
+	for i := 0; i < max(len(aParts), len(bParts)); i++ {
+		if c := compareVersionParts(versionPart(aParts, i), versionPart(bParts, i)); c != 0 {
+			return c
+		}
+	}
+
+	// a version without a pre-release label is higher than one with
+	switch {
+	case aPreRelease == bPreRelease:
+		return 0
+	case aPreRelease == "":
+		return 1
+	case bPreRelease == "":
+		return -1
+	}
+
+	aLabels := strings.Split(aPreRelease, ".")
+	bLabels := strings.Split(bPreRelease, ".")
+	for i := 0; i < min(len(aLabels), len(bLabels)); i++ {
+		if c := compareVersionParts(aLabels[i], bLabels[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(aLabels), len(bLabels))
+}
+
+func splitVersion(version string) (string, string) {
+	version, _, _ = strings.Cut(version, "+")
+	release, preRelease, _ := strings.Cut(version, "-")
+	return release, preRelease
+}
+
+func versionPart(parts []string, i int) string {
+	if i < len(parts) {
+		return parts[i]
+	}
+	return "0"
+}
+
+// compareVersionParts compares numeric parts numerically and others
+// case-insensitively, with numeric parts lower than others.
+func compareVersionParts(a string, b string) int {
+	aNumber, aErr := strconv.ParseUint(a, 10, 64)
+	bNumber, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
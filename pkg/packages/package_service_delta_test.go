@@ -0,0 +1,205 @@
+package packages
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func readUploadedFile(t *testing.T, r *http.Request) (string, []byte) {
+	file, header, err := r.FormFile("file")
+	require.NoError(t, err)
+	defer file.Close()
+	contents, err := io.ReadAll(file)
+	require.NoError(t, err)
+	return header.Filename, contents
+}
+
+func TestUploadDeltaUploadsDeltaAgainstNearestEarlierVersion(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	base := make([]byte, 64*1024)
+	random.Read(base)
+	newPackage := append(append([]byte{}, base[:30000]...), []byte("changed")...)
+	newPackage = append(newPackage, base[30000:]...)
+
+	var deltaUploaded bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/packages", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "MyApp", r.URL.Query().Get("nuGetPackageId"))
+		_, _ = w.Write([]byte(`{"Items":[
+			{"PackageId":"MyApp","Version":"2.0.0"},
+			{"PackageId":"MyApp","Version":"1.10.0-beta"},
+			{"PackageId":"MyApp","Version":"1.9.1"},
+			{"PackageId":"MyApp","Version":"1.2.0"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/packages/MyApp/1.10.0-beta/delta-signature", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(PackageSignatureResponse{BaseVersion: "1.10.0-beta", Signature: buildSignature(t, base, 2048)})
+	})
+	mux.HandleFunc("POST /api/Spaces-1/packages/MyApp/1.10.0-beta/delta", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "OverwriteExisting", r.URL.Query().Get("overwriteMode"))
+		fileName, delta := readUploadedFile(t, r)
+		require.Equal(t, "MyApp.1.10.0.zip", fileName)
+
+		result, dataLength := applyDelta(t, base, delta)
+		require.True(t, bytes.Equal(newPackage, result))
+		require.Less(t, dataLength, 2*2048+len("changed"))
+		deltaUploaded = true
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"PackageId":"MyApp","Version":"1.10.0"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	response, created, err := UploadDelta(client, "Spaces-1", "MyApp.1.10.0.zip", bytes.NewReader(newPackage), OverwriteModeOverwriteExisting)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.True(t, deltaUploaded)
+	require.Equal(t, "1.10.0", response.Version)
+}
+
+func TestUploadDeltaFallsBackToFullUpload(t *testing.T) {
+	newPackage := []byte("the whole package")
+
+	testCases := []struct {
+		name     string
+		fileName string
+		items    string
+		noSig    bool
+	}{
+		{"NoEarlierVersion", "MyApp.1.0.0.zip", `[{"PackageId":"MyApp","Version":"1.0.0"},{"PackageId":"MyApp","Version":"1.0.1"}]`, false},
+		{"SignatureNotFound", "MyApp.1.0.0.zip", `[{"PackageId":"MyApp","Version":"0.9.0"}]`, true},
+		{"UnrecognisedFileName", "package.zip", `[]`, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var uploaded []byte
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/Spaces-1/packages", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"Items":` + tc.items + `}`))
+			})
+			mux.HandleFunc("GET /api/Spaces-1/packages/MyApp/0.9.0/delta-signature", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"ErrorMessage":"The package could not be found"}`))
+			})
+			mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+				_, uploaded = readUploadedFile(t, r)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(`{"PackageId":"MyApp","Version":"1.0.0"}`))
+			})
+			client := testutil.NewTestClient(t, mux)
+
+			_, created, err := UploadDelta(client, "Spaces-1", tc.fileName, bytes.NewReader(newPackage), "")
+			require.NoError(t, err)
+			require.False(t, created)
+			require.Equal(t, newPackage, uploaded)
+		})
+	}
+}
+
+// slowPackageReader yields a package slowly, and records whether it is read
+// after ended is set.
+type slowPackageReader struct {
+	size            int
+	offset          int
+	ended           atomic.Bool
+	readAfterEnding atomic.Bool
+}
+
+func (r *slowPackageReader) Read(p []byte) (int, error) {
+	if r.ended.Load() {
+		r.readAfterEnding.Store(true)
+	}
+	if r.offset == r.size {
+		return 0, io.EOF
+	}
+	time.Sleep(time.Millisecond)
+	n := min(min(len(p), 1024), r.size-r.offset)
+	r.offset += n
+	return n, nil
+}
+
+func (r *slowPackageReader) Seek(offset int64, whence int) (int64, error) {
+	r.offset = int(offset)
+	return offset, nil
+}
+
+var errConnectionReset = errors.New("connection reset")
+
+// failingUploadTransport fails every upload without reading its body, as
+// happens when the connection is lost before the upload starts.
+type failingUploadTransport struct {
+	http.RoundTripper
+}
+
+func (t *failingUploadTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodPost {
+		return nil, errConnectionReset
+	}
+	return t.RoundTripper.RoundTrip(r)
+}
+
+func TestUploadDeltaStopsReadingPackageWhenUploadFails(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/packages", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items":[{"PackageId":"MyApp","Version":"1.0.0"}]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/packages/MyApp/1.0.0/delta-signature", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(PackageSignatureResponse{BaseVersion: "1.0.0", Signature: buildSignature(t, make([]byte, 4096), 2048)})
+	})
+	client := testutil.NewTestClient(t, mux)
+	httpClient := client.HttpSession().HttpClient
+	httpClient.Transport = &failingUploadTransport{RoundTripper: httpClient.Transport}
+
+	// reading the whole package takes seconds, far longer than the upload
+	reader := &slowPackageReader{size: 4 * 1024 * 1024}
+	_, _, err := UploadDelta(client, "Spaces-1", "MyApp.1.1.0.zip", reader, "")
+	reader.ended.Store(true)
+	require.ErrorIs(t, err, errConnectionReset)
+
+	time.Sleep(50 * time.Millisecond)
+	require.False(t, reader.readAfterEnding.Load(), "the package was read after UploadDelta returned")
+}
+
+func TestParsePackageFileName(t *testing.T) {
+	testCases := []struct {
+		fileName  string
+		packageID string
+		version   string
+		ok        bool
+	}{
+		{"MyApp.1.0.0.zip", "MyApp", "1.0.0", true},
+		{"My.App.Web.2.1.0-beta.3.tar.gz", "My.App.Web", "2.1.0-beta.3", true},
+		{"MyApp.2024.1.5+build.7.nupkg", "MyApp", "2024.1.5+build.7", true},
+		{"MyApp.zip", "", "", false},
+		{"MyApp.1.0.0.exe", "", "", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			packageID, version, ok := parsePackageFileName(tc.fileName)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.packageID, packageID)
+			require.Equal(t, tc.version, version)
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "1.0.1", "1.2", "1.10.0"}
+	for i := range ordered {
+		for j := range ordered {
+			require.Equal(t, cmp.Compare(i, j), compareVersions(ordered[i], ordered[j]), "%s <=> %s", ordered[i], ordered[j])
+		}
+	}
+	require.Equal(t, 0, compareVersions("1.0", "1.0.0+build"))
+}
@@ -0,0 +1,160 @@
+package packages
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+// Download streams the contents of a package in the octopus server's builtin
+// package feed to w, and returns the package. The SHA1 hash of the contents is
+// checked against the hash the server reports for the package, and a mismatch
+// is reported as ErrHashMismatch.
+//
+// The returned package carries the FileExtension needed to upload the
+// contents to another server with Upload, under the file name
+// "<PackageID>.<Version><FileExtension>".
+func Download(client newclient.Client, spaceID string, packageID string, version string, w io.Writer, options *DownloadOptions) (*Package, error) {
+	return DownloadWithContext(context.Background(), client, spaceID, packageID, version, w, options)
+}
+
+// DownloadWithContext is like Download, but uses ctx to control cancellation of the HTTP requests.
+func DownloadWithContext(ctx context.Context, client newclient.Client, spaceID string, packageID string, version string, w io.Writer, options *DownloadOptions) (*Package, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if internal.IsEmpty(packageID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("packageID")
+	}
+	if internal.IsEmpty(version) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("version")
+	}
+	if w == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("w")
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = &DownloadOptions{}
+	}
+
+	ID := "packages-" + packageID + "." + version
+	octopusPackage, err := newclient.GetByIDWithContext[Package](ctx, client, uritemplates.Packages, spaceID, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := client.URITemplateCache().Expand(uritemplates.PackageRaw, map[string]any{
+		"spaceId": spaceID,
+		"id":      ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	download := &packageDownload{w: w, hash: sha1.New()}
+	attempts := max(options.Attempts, 1)
+	for attempt := 1; ; attempt++ {
+		err := download.resume(ctx, client, path)
+		if err == nil {
+			break
+		}
+		if attempt >= attempts || download.writeErr != nil || !isRetryableTransferError(ctx, err) {
+			return nil, err
+		}
+	}
+
+	if octopusPackage.Hash != "" && !strings.EqualFold(octopusPackage.Hash, hex.EncodeToString(download.hash.Sum(nil))) {
+		return octopusPackage, fmt.Errorf("%w: %s", ErrHashMismatch, ID)
+	}
+	return octopusPackage, nil
+}
+
+// packageDownload tracks how much of a package has been written, so that an
+// interrupted download can be resumed.
+type packageDownload struct {
+	w        io.Writer
+	hash     hash.Hash
+	written  int64
+	writeErr error
+}
+
+func (d *packageDownload) Write(p []byte) (int, error) {
+	n, err := d.w.Write(p)
+	d.hash.Write(p[:n])
+	d.written += int64(n)
+	if err != nil {
+		d.writeErr = err
+	}
+	return n, err
+}
+
+// resume requests the part of the package that has not yet been written.
+func (d *packageDownload) resume(ctx context.Context, client newclient.Client, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	if d.written > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(d.written, 10)+"-")
+	}
+
+	resp, err := client.HttpSession().DoRawRequest(req)
+	if err != nil {
+		return err
+	}
+	defer newclient.CloseResponse(resp)
+
+	body := io.Reader(resp.Body)
+	switch {
+	case resp.StatusCode == http.StatusPartialContent:
+		start, err := parseContentRangeStart(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return err
+		}
+		if start != d.written {
+			return fmt.Errorf("the server resumed the download at byte %d instead of byte %d", start, d.written)
+		}
+	case resp.StatusCode == http.StatusOK:
+		// the server ignored the range, so skip what has already been written
+		if _, err := io.CopyN(io.Discard, body, d.written); err != nil {
+			return err
+		}
+	default:
+		apiError := new(core.APIError)
+		_ = json.NewDecoder(body).Decode(apiError)
+		return core.NewAPIError(resp, apiError)
+	}
+
+	_, err = io.Copy(d, body)
+	return err
+}
+
+func parseContentRangeStart(contentRange string) (int64, error) {
+	// Content-Range: bytes <start>-<end>/<size>
+	rangeSpec, ok := strings.CutPrefix(contentRange, "bytes ")
+	if !ok {
+		return 0, errors.New("the server returned an invalid Content-Range: " + contentRange)
+	}
+	start, _, _ := strings.Cut(rangeSpec, "-")
+	value, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0, errors.New("the server returned an invalid Content-Range: " + contentRange)
+	}
+	return value, nil
+}
@@ -0,0 +1,109 @@
+package packages
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newDownloadTestMux(t *testing.T, contents []byte, hash string, interruptions int32) (*http.ServeMux, *atomic.Int32, *[]string) {
+	var requests atomic.Int32
+	var ranges []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/packages/packages-MyApp.1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"Id":"packages-MyApp.1.0.0","PackageId":"MyApp","Version":"1.0.0","FileExtension":".zip","Hash":"%s","PackageSizeBytes":%d}`, hash, len(contents))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/packages/packages-MyApp.1.0.0/raw", func(w http.ResponseWriter, r *http.Request) {
+		ranges = append(ranges, r.Header.Get("Range"))
+		if requests.Add(1) <= interruptions {
+			// send part of the package, then drop the connection
+			w.Header().Set("Content-Length", fmt.Sprint(len(contents)))
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(contents[:len(contents)/3])
+			w.(http.Flusher).Flush()
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, "MyApp.1.0.0.zip", time.Time{}, bytes.NewReader(contents))
+	})
+	return mux, &requests, &ranges
+}
+
+func TestDownloadResumesInterruptedDownloads(t *testing.T) {
+	contents := make([]byte, 256*1024)
+	rand.New(rand.NewSource(3)).Read(contents)
+
+	mux, requests, ranges := newDownloadTestMux(t, contents, sha1Hex(contents), 1)
+	client := testutil.NewTestClient(t, mux)
+
+	var downloaded bytes.Buffer
+	octopusPackage, err := Download(client, "Spaces-1", "MyApp", "1.0.0", &downloaded, &DownloadOptions{Attempts: 2})
+	require.NoError(t, err)
+	require.Equal(t, ".zip", octopusPackage.FileExtension)
+	require.Equal(t, int64(len(contents)), octopusPackage.PackageSizeBytes)
+	require.True(t, bytes.Equal(contents, downloaded.Bytes()))
+
+	require.Equal(t, int32(2), requests.Load())
+	require.Equal(t, "", (*ranges)[0])
+	require.Regexp(t, `^bytes=\d+-$`, (*ranges)[1])
+	require.NotEqual(t, "bytes=0-", (*ranges)[1])
+}
+
+func TestDownloadGivesUpAfterAttempts(t *testing.T) {
+	contents := []byte("package contents which will be interrupted")
+
+	mux, requests, _ := newDownloadTestMux(t, contents, sha1Hex(contents), 5)
+	client := testutil.NewTestClient(t, mux)
+
+	_, err := Download(client, "Spaces-1", "MyApp", "1.0.0", &bytes.Buffer{}, &DownloadOptions{Attempts: 2})
+	require.Error(t, err)
+	require.Equal(t, int32(2), requests.Load())
+}
+
+func TestDownloadDetectsHashMismatch(t *testing.T) {
+	contents := []byte("package contents")
+
+	mux, _, _ := newDownloadTestMux(t, contents, sha1Hex([]byte("other contents")), 0)
+	client := testutil.NewTestClient(t, mux)
+
+	_, err := Download(client, "Spaces-1", "MyApp", "1.0.0", &bytes.Buffer{}, nil)
+	require.ErrorIs(t, err, ErrHashMismatch)
+}
+
+func TestDownloadReturnsTypedErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/packages/packages-MyApp.1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"The resource 'packages-MyApp.1.0.0' was not found."}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	_, err := Download(client, "Spaces-1", "MyApp", "1.0.0", &bytes.Buffer{}, nil)
+	require.ErrorIs(t, err, core.ErrNotFound)
+}
+
+func TestDownloadDecodesErrorsOfRawContent(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/packages/packages-MyApp.1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id":"packages-MyApp.1.0.0","PackageId":"MyApp","Version":"1.0.0"}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/packages/packages-MyApp.1.0.0/raw", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"You do not have permission to perform this action.","Errors":["Missing FeedView"]}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	_, err := Download(client, "Spaces-1", "MyApp", "1.0.0", &bytes.Buffer{}, nil)
+	require.ErrorIs(t, err, core.ErrForbidden)
+	var apiError *core.APIError
+	require.ErrorAs(t, err, &apiError)
+	require.Equal(t, "You do not have permission to perform this action.", apiError.ErrorMessage)
+	require.Equal(t, []string{"Missing FeedView"}, apiError.Errors)
+}
@@ -0,0 +1,189 @@
+package packages
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+// ErrHashMismatch is returned when the SHA1 hash of a package that was
+// uploaded or downloaded differs from the hash the server computed for it.
+var ErrHashMismatch = errors.New("the hash of the package does not match the hash computed by the server")
+
+// UploadWithOptions uploads a package to the octopus server's builtin package
+// feed, like Upload, while reporting progress and retrying as set out in
+// options. The SHA1 hash of the package is computed as it is sent, and if the
+// server creates a new package its hash is checked against it; a mismatch is
+// reported as ErrHashMismatch.
+func UploadWithOptions(client newclient.Client, spaceID string, fileName string, reader io.Reader, options *UploadOptions) (*PackageUploadResponse, bool, error) {
+	return UploadWithOptionsWithContext(context.Background(), client, spaceID, fileName, reader, options)
+}
+
+// UploadWithOptionsWithContext is like UploadWithOptions, but uses ctx to control cancellation of the HTTP requests.
+func UploadWithOptionsWithContext(ctx context.Context, client newclient.Client, spaceID string, fileName string, reader io.Reader, options *UploadOptions) (*PackageUploadResponse, bool, error) {
+	if client == nil {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if spaceID == "" {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
+	}
+	if fileName == "" {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("fileName")
+	}
+	if reader == nil {
+		return nil, false, internal.CreateRequiredParameterIsEmptyOrNilError("reader")
+	}
+	if options == nil {
+		options = &UploadOptions{}
+	}
+
+	params := map[string]any{
+		"spaceId": spaceID,
+	}
+	if options.OverwriteMode != "" {
+		params["overwriteMode"] = options.OverwriteMode
+	}
+	expandedUri, err := client.URITemplateCache().Expand(uritemplates.PackageUpload, params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	seeker, canSeek := reader.(io.Seeker)
+	var start, totalBytes int64
+	if canSeek {
+		if start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			return nil, false, err
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, false, err
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, false, err
+		}
+		totalBytes = end - start
+	}
+
+	attempts := 1
+	if canSeek && options.Attempts > 1 {
+		attempts = options.Attempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		progressReader := &progressReader{
+			reader:   reader,
+			hash:     sha1.New(),
+			progress: options.Progress,
+			report: UploadProgress{
+				FileName:   fileName,
+				Attempt:    attempt,
+				TotalBytes: totalBytes,
+			},
+		}
+
+		response, created, err := postPackageWithContext(ctx, client, expandedUri, fileName, progressReader)
+		if err == nil {
+			if created && response.Hash != "" && !strings.EqualFold(response.Hash, hex.EncodeToString(progressReader.hash.Sum(nil))) {
+				return response, created, fmt.Errorf("%w: %s", ErrHashMismatch, fileName)
+			}
+			return response, created, nil
+		}
+		if attempt >= attempts || !isRetryableTransferError(ctx, err) {
+			return nil, false, err
+		}
+		if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+// isRetryableTransferError reports whether an upload or download which failed
+// with err is worth trying again.
+func isRetryableTransferError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiError *core.APIError
+	if errors.As(err, &apiError) {
+		return errors.Is(err, core.ErrServerError) || errors.Is(err, core.ErrRateLimited)
+	}
+	return true
+}
+
+// UploadMany uploads several packages to the octopus server's builtin package
+// feed, with at most concurrency uploads in progress at once. Each package is
+// uploaded as it would be by UploadWithOptions, and the result of each is
+// returned in the same order as files; a failure to upload one package does
+// not stop the others.
+func UploadMany(client newclient.Client, spaceID string, files []*PackageUploadFile, concurrency int, options *UploadOptions) []*PackageUploadResult {
+	return UploadManyWithContext(context.Background(), client, spaceID, files, concurrency, options)
+}
+
+// UploadManyWithContext is like UploadMany, but uses ctx to control cancellation of the HTTP requests.
+func UploadManyWithContext(ctx context.Context, client newclient.Client, spaceID string, files []*PackageUploadFile, concurrency int, options *UploadOptions) []*PackageUploadResult {
+	results := make([]*PackageUploadResult, len(files))
+	concurrency = max(1, min(concurrency, len(files)))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = uploadFile(ctx, client, spaceID, files[i], options)
+			}
+		}()
+	}
+	for i := range files {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+func uploadFile(ctx context.Context, client newclient.Client, spaceID string, file *PackageUploadFile, options *UploadOptions) *PackageUploadResult {
+	if file == nil {
+		return &PackageUploadResult{Err: internal.CreateRequiredParameterIsEmptyOrNilError("file")}
+	}
+	result := &PackageUploadResult{FileName: file.FileName}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+	result.Response, result.Created, result.Err = UploadWithOptionsWithContext(ctx, client, spaceID, file.FileName, file.Reader, options)
+	return result
+}
+
+// progressReader hashes a package and reports progress as it is read.
+type progressReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	progress func(UploadProgress)
+	report   UploadProgress
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	if n > 0 {
+		p.hash.Write(b[:n])
+		p.report.BytesSent += int64(n)
+		if p.progress != nil {
+			p.progress(p.report)
+		}
+	}
+	return n, err
+}
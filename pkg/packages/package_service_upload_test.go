@@ -0,0 +1,173 @@
+package packages
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func sha1Hex(data []byte) string {
+	hash := sha1.Sum(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func TestUploadWithOptionsReportsProgressAndVerifiesHash(t *testing.T) {
+	contents := bytes.Repeat([]byte("package contents "), 10000)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "FailIfExists", r.URL.Query().Get("overwriteMode"))
+		_, uploaded := readUploadedFile(t, r)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"PackageId":"MyApp","Version":"1.0.0","Hash":"%s"}`, strings.ToUpper(sha1Hex(uploaded)))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	var reports []UploadProgress
+	options := &UploadOptions{
+		OverwriteMode: OverwriteModeFailIfExists,
+		Progress:      func(progress UploadProgress) { reports = append(reports, progress) },
+	}
+	response, created, err := UploadWithOptions(client, "Spaces-1", "MyApp.1.0.0.zip", bytes.NewReader(contents), options)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, "MyApp", response.PackageId)
+
+	require.NotEmpty(t, reports)
+	last := reports[len(reports)-1]
+	require.Equal(t, UploadProgress{FileName: "MyApp.1.0.0.zip", Attempt: 1, BytesSent: int64(len(contents)), TotalBytes: int64(len(contents))}, last)
+}
+
+func TestUploadWithOptionsDetectsHashMismatch(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"Hash":"%s"}`, sha1Hex([]byte("something else")))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	_, _, err := UploadWithOptions(client, "Spaces-1", "MyApp.1.0.0.zip", strings.NewReader("package contents"), nil)
+	require.ErrorIs(t, err, ErrHashMismatch)
+}
+
+func TestUploadWithOptionsResendsAfterServerErrors(t *testing.T) {
+	contents := []byte("package contents")
+
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+		_, uploaded := readUploadedFile(t, r)
+		require.Equal(t, contents, uploaded)
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"ErrorMessage":"try again"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"Hash":"%s"}`, sha1Hex(uploaded))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	var attempts []int
+	options := &UploadOptions{
+		Attempts: 3,
+		Progress: func(progress UploadProgress) { attempts = append(attempts, progress.Attempt) },
+	}
+	_, created, err := UploadWithOptions(client, "Spaces-1", "MyApp.1.0.0.zip", bytes.NewReader(contents), options)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Equal(t, int32(3), requests.Load())
+	require.Equal(t, []int{1, 2, 3}, attempts)
+
+	// packages which cannot be rewound are only sent once
+	requests.Store(0)
+	_, _, err = UploadWithOptions(client, "Spaces-1", "MyApp.1.0.0.zip", io.MultiReader(bytes.NewReader(contents)), options)
+	require.ErrorIs(t, err, core.ErrServerError)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestUploadWithOptionsDoesNotResendAfterClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"A package with the same ID and version already exists"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	_, _, err := UploadWithOptions(client, "Spaces-1", "MyApp.1.0.0.zip", strings.NewReader("package"), &UploadOptions{Attempts: 5})
+	require.ErrorIs(t, err, core.ErrValidation)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func TestUploadManyBoundsConcurrencyAndReturnsResultsInOrder(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/packages/raw", func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		fileName, uploaded := readUploadedFile(t, r)
+		if fileName == "Broken.1.0.0.zip" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"ErrorMessage":"invalid package"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"PackageId":"%s","Hash":"%s"}`, strings.TrimSuffix(fileName, ".1.0.0.zip"), sha1Hex(uploaded))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	var files []*PackageUploadFile
+	for i := range 8 {
+		name := fmt.Sprintf("App%d", i)
+		if i == 5 {
+			name = "Broken"
+		}
+		files = append(files, &PackageUploadFile{FileName: name + ".1.0.0.zip", Reader: strings.NewReader("contents of " + name)})
+	}
+
+	var mu sync.Mutex
+	progressed := map[string]bool{}
+	options := &UploadOptions{Progress: func(progress UploadProgress) {
+		mu.Lock()
+		defer mu.Unlock()
+		progressed[progress.FileName] = true
+	}}
+
+	results := UploadMany(client, "Spaces-1", files, 3, options)
+	require.Len(t, results, len(files))
+	require.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	require.Greater(t, maxInFlight.Load(), int32(1))
+	for i, result := range results {
+		require.Equal(t, files[i].FileName, result.FileName)
+		require.True(t, progressed[result.FileName])
+		if i == 5 {
+			require.ErrorIs(t, result.Err, core.ErrValidation)
+			continue
+		}
+		require.NoError(t, result.Err)
+		require.True(t, result.Created)
+		require.Equal(t, fmt.Sprintf("App%d", i), result.Response.PackageId)
+	}
+}
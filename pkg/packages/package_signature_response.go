@@ -0,0 +1,8 @@
+package packages
+
+// PackageSignatureResponse is the signature of a package in the built-in
+// feed, against which a delta for a later version can be computed.
+type PackageSignatureResponse struct {
+	BaseVersion string `json:"BaseVersion,omitempty"`
+	Signature   []byte `json:"Signature,omitempty"`
+}
@@ -0,0 +1,56 @@
+package packages
+
+import "io"
+
+// UploadOptions controls how a package is uploaded by UploadWithOptions and
+// UploadMany.
+type UploadOptions struct {
+	// OverwriteMode instructs the server what to do in the case that the
+	// package already exists.
+	OverwriteMode OverwriteMode
+
+	// Progress, if set, is called as the package is sent to the server. When
+	// several packages are uploaded concurrently it is called from several
+	// goroutines, so it must be safe for concurrent use.
+	Progress func(UploadProgress)
+
+	// Attempts is the number of times to try sending a package before giving
+	// up. The server cannot accept part of a package, so an upload is resumed
+	// by sending the package again from its start; packages that are not
+	// provided as an io.Seeker are only tried once. Only network failures and
+	// server errors are retried. Zero means one attempt.
+	Attempts int
+}
+
+// UploadProgress reports how much of a package has been sent.
+type UploadProgress struct {
+	FileName string
+
+	// Attempt is the number of the attempt in progress, starting from 1.
+	Attempt int
+
+	BytesSent int64
+
+	// TotalBytes is the size of the package, or zero if it is not known
+	// because the package is not provided as an io.Seeker.
+	TotalBytes int64
+}
+
+// PackageUploadFile is a package to be uploaded by UploadMany.
+type PackageUploadFile struct {
+	// FileName is the name we tell the server to use for the package.
+	FileName string
+	Reader   io.Reader
+}
+
+// PackageUploadResult is the outcome of uploading one package with
+// UploadMany.
+type PackageUploadResult struct {
+	FileName string
+	Response *PackageUploadResponse
+
+	// Created is true if the server created a new package, and false if it
+	// ignored an existing one.
+	Created bool
+	Err     error
+}
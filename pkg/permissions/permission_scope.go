@@ -0,0 +1,83 @@
+package permissions
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// PermissionScope identifies the resources a permission is checked against.
+// An empty ID matches any resource of that kind, so a scope with only an
+// EnvironmentID asks whether the permission is granted for that environment
+// on at least one project and tenant.
+//
+// A project belongs to a project group, so a scope with a ProjectID should
+// also have the project's ProjectGroupID. Without it, a restriction to other
+// project groups is not detected. users.HasPermission fills it in.
+type PermissionScope struct {
+	EnvironmentID  string
+	ProjectGroupID string
+	ProjectID      string
+	TenantID       string
+}
+
+// Restrictions returns the restrictions of the named permission, such as
+// "DeploymentCreate". The name is that of the field of SpacePermissions. A
+// permission which is not granted has no restrictions.
+func (p *SpacePermissions) Restrictions(permission string) ([]UserPermissionRestriction, error) {
+	field := reflect.ValueOf(p).Elem().FieldByName(permission)
+	if !field.IsValid() {
+		return nil, fmt.Errorf("unknown permission %q", permission)
+	}
+	return field.Interface().([]UserPermissionRestriction), nil
+}
+
+// Includes returns whether the restriction grants its permission in the input
+// space and scope. An empty list of IDs in the restriction is unrestricted.
+func (r *UserPermissionRestriction) Includes(spaceID string, scope PermissionScope) bool {
+	if len(r.SpaceID) > 0 && r.SpaceID != spaceID {
+		return false
+	}
+	return isRestrictedTo(r.RestrictedToEnvironmentIds, scope.EnvironmentID) &&
+		isRestrictedTo(r.RestrictedToProjectGroupIds, scope.ProjectGroupID) &&
+		isRestrictedTo(r.RestrictedToProjectIds, scope.ProjectID) &&
+		isRestrictedTo(r.RestrictedToTenantIds, scope.TenantID)
+}
+
+// RestrictionsFor returns the restrictions of the named space permission
+// which grant it in the input space and scope. The permission is granted if
+// any are returned.
+func (s *UserPermissionSet) RestrictionsFor(spaceID string, permission string, scope PermissionScope) ([]UserPermissionRestriction, error) {
+	restrictions, err := s.SpacePermissions.Restrictions(permission)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := []UserPermissionRestriction{}
+	for _, restriction := range restrictions {
+		if restriction.Includes(spaceID, scope) {
+			granted = append(granted, restriction)
+		}
+	}
+	return granted, nil
+}
+
+// Can returns whether the named space permission is granted in the input
+// space and scope.
+func (s *UserPermissionSet) Can(spaceID string, permission string, scope PermissionScope) (bool, error) {
+	granted, err := s.RestrictionsFor(spaceID, permission, scope)
+	if err != nil {
+		return false, err
+	}
+	return len(granted) > 0, nil
+}
+
+// HasSystemPermission returns whether the named system permission, such as
+// "AdministerSystem", is granted.
+func (s *UserPermissionSet) HasSystemPermission(permission string) bool {
+	return slices.Contains(s.SystemPermissions, permission)
+}
+
+func isRestrictedTo(ids []string, id string) bool {
+	return len(ids) == 0 || len(id) == 0 || slices.Contains(ids, id)
+}
@@ -1,6 +1,8 @@
 package projectgroups
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -207,6 +209,11 @@ func (s *ProjectGroupService) Update(resource ProjectGroup) (*ProjectGroup, erro
 
 // Add creates a new project group.
 func Add(client newclient.Client, projectGroup *ProjectGroup) (*ProjectGroup, error) {
+	return AddWithContext(context.Background(), client, projectGroup)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, projectGroup *ProjectGroup) (*ProjectGroup, error) {
 	if IsNil(projectGroup) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterProjectGroup)
 	}
@@ -221,13 +228,18 @@ func Add(client newclient.Client, projectGroup *ProjectGroup) (*ProjectGroup, er
 		return nil, err
 	}
 
-	return newclient.Post[ProjectGroup](client.HttpSession(), expandedUri, projectGroup)
+	return newclient.PostWithContext[ProjectGroup](ctx, client.HttpSession(), expandedUri, projectGroup)
 }
 
 // Get returns a collection of project groups based on the criteria defined by
 // its input query parameter. If an error occurs, an empty collection is
 // returned along with the associated error.
 func Get(client newclient.Client, spaceID string, projectGroupsQuery ProjectGroupsQuery) (*resources.Resources[*ProjectGroup], error) {
+	return GetWithContext(context.Background(), client, spaceID, projectGroupsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, projectGroupsQuery ProjectGroupsQuery) (*resources.Resources[*ProjectGroup], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -244,7 +256,7 @@ func Get(client newclient.Client, spaceID string, projectGroupsQuery ProjectGrou
 		return nil, err
 	}
 
-	resp, err := newclient.Get[resources.Resources[*ProjectGroup]](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[resources.Resources[*ProjectGroup]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*ProjectGroup]{}, err
 	}
@@ -252,9 +264,26 @@ func Get(client newclient.Client, spaceID string, projectGroupsQuery ProjectGrou
 	return resp, nil
 }
 
+// Iterate returns an iterator over the project groups matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query ProjectGroupsQuery) iter.Seq2[*ProjectGroup, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query ProjectGroupsQuery) iter.Seq2[*ProjectGroup, error] {
+	return newclient.IterateByQueryWithContext[ProjectGroup](ctx, client, projectGroupsTemplate, spaceID, query)
+}
+
 // GetByID returns the project group that matches the input ID. If one cannot
 // be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (*ProjectGroup, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (*ProjectGroup, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -272,11 +301,16 @@ func GetByID(client newclient.Client, spaceID string, id string) (*ProjectGroup,
 		return nil, err
 	}
 
-	return newclient.Get[ProjectGroup](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[ProjectGroup](ctx, client.HttpSession(), expandedUri)
 }
 
 // Update modifies a project group based on the one provided as input.
 func Update(client newclient.Client, resource ProjectGroup) (*ProjectGroup, error) {
+	return UpdateWithContext(context.Background(), client, resource)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, resource ProjectGroup) (*ProjectGroup, error) {
 	spaceID, err := internal.GetSpaceID(resource.SpaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -290,11 +324,16 @@ func Update(client newclient.Client, resource ProjectGroup) (*ProjectGroup, erro
 		return nil, err
 	}
 
-	return newclient.Put[ProjectGroup](client.HttpSession(), expandedUri, resource)
+	return newclient.PutWithContext[ProjectGroup](ctx, client.HttpSession(), expandedUri, resource)
 }
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -307,10 +346,15 @@ func DeleteByID(client newclient.Client, spaceID string, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
 
 // GetAll returns all project groups. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*ProjectGroup, error) {
-	return newclient.GetAll[ProjectGroup](client, projectGroupsTemplate, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*ProjectGroup, error) {
+	return newclient.GetAllWithContext[ProjectGroup](ctx, client, projectGroupsTemplate, spaceID)
 }
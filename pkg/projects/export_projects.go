@@ -0,0 +1,32 @@
+package projects
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/go-playground/validator/v10"
+)
+
+// ExportProjectsCommand exports one or more projects, and the resources they
+// depend on, into an archive attached to the server task which performs the
+// export. Sensitive values in the archive are encrypted with the password.
+type ExportProjectsCommand struct {
+	IncludedProjectIDs []string             `json:"IncludedProjectIds" validate:"required,min=1"`
+	Password           *core.SensitiveValue `json:"Password" validate:"required"`
+}
+
+// ExportProjectsResponse is returned by the server when a project export is submitted.
+type ExportProjectsResponse struct {
+	TaskID string `json:"TaskId"`
+}
+
+// NewExportProjectsCommand creates and initializes a command to export the input projects.
+func NewExportProjectsCommand(password string, projectIDs ...string) *ExportProjectsCommand {
+	return &ExportProjectsCommand{
+		IncludedProjectIDs: projectIDs,
+		Password:           core.NewSensitiveValue(password),
+	}
+}
+
+// Validate checks the state of the command and returns an error if invalid.
+func (c *ExportProjectsCommand) Validate() error {
+	return validator.New().Struct(c)
+}
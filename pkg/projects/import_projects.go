@@ -0,0 +1,48 @@
+package projects
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/go-playground/validator/v10"
+)
+
+// ImportSourceTypeSpace identifies an import source which is the archive
+// produced by a project export task in a space on the same server.
+const ImportSourceTypeSpace = "space"
+
+// ImportProjectsSource identifies the archive to import projects from.
+type ImportProjectsSource struct {
+	SpaceID string `json:"SpaceId" validate:"required"`
+	TaskID  string `json:"TaskId" validate:"required"`
+	Type    string `json:"Type" validate:"required"`
+}
+
+// ImportProjectsCommand imports the projects contained in the archive produced
+// by a project export. The password must match the one used for the export.
+type ImportProjectsCommand struct {
+	ImportSource *ImportProjectsSource `json:"ImportSource" validate:"required"`
+	Password     *core.SensitiveValue  `json:"Password" validate:"required"`
+}
+
+// ImportProjectsResponse is returned by the server when a project import is submitted.
+type ImportProjectsResponse struct {
+	TaskID string `json:"TaskId"`
+}
+
+// NewImportProjectsCommand creates and initializes a command to import the
+// projects exported by the task that matches the input task ID, in the space
+// that matches the input space ID.
+func NewImportProjectsCommand(exportSpaceID string, exportTaskID string, password string) *ImportProjectsCommand {
+	return &ImportProjectsCommand{
+		ImportSource: &ImportProjectsSource{
+			SpaceID: exportSpaceID,
+			TaskID:  exportTaskID,
+			Type:    ImportSourceTypeSpace,
+		},
+		Password: core.NewSensitiveValue(password),
+	}
+}
+
+// Validate checks the state of the command and returns an error if invalid.
+func (c *ImportProjectsCommand) Validate() error {
+	return validator.New().Struct(c)
+}
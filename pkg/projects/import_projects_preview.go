@@ -0,0 +1,53 @@
+package projects
+
+// ImportProjectsPreview describes what an import would do without performing it.
+type ImportProjectsPreview struct {
+	Dependencies []*ImportProjectsPreviewDependency `json:"Dependencies"`
+	Errors       []string                           `json:"Errors,omitempty"`
+	Projects     []*ImportProjectsPreviewProject    `json:"Projects"`
+}
+
+// ImportProjectsPreviewProject is a project contained in the archive being imported.
+type ImportProjectsPreviewProject struct {
+	ExistsInDestination bool   `json:"ExistsInDestination"`
+	ID                  string `json:"Id"`
+	Name                string `json:"Name"`
+}
+
+// ImportProjectsPreviewDependency is a resource which the imported projects
+// depend on, such as an environment, account or feed. If a matching resource
+// already exists in the destination space, DestinationID identifies it;
+// otherwise the dependency will be created by the import.
+type ImportProjectsPreviewDependency struct {
+	DestinationID   string `json:"DestinationId,omitempty"`
+	DestinationName string `json:"DestinationName,omitempty"`
+	SourceID        string `json:"SourceId"`
+	SourceName      string `json:"SourceName"`
+	Type            string `json:"Type"`
+}
+
+// DependencyMapping returns the IDs of the dependencies in the source space
+// mapped to the IDs of the existing resources in the destination space that
+// they will be matched with. Dependencies which will be created by the import
+// are not included.
+func (p *ImportProjectsPreview) DependencyMapping() map[string]string {
+	mapping := map[string]string{}
+	for _, dependency := range p.Dependencies {
+		if dependency.DestinationID != "" {
+			mapping[dependency.SourceID] = dependency.DestinationID
+		}
+	}
+	return mapping
+}
+
+// UnmatchedDependencies returns the dependencies which have no matching
+// resource in the destination space.
+func (p *ImportProjectsPreview) UnmatchedDependencies() []*ImportProjectsPreviewDependency {
+	unmatched := []*ImportProjectsPreviewDependency{}
+	for _, dependency := range p.Dependencies {
+		if dependency.DestinationID == "" {
+			unmatched = append(unmatched, dependency)
+		}
+	}
+	return unmatched
+}
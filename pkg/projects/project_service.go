@@ -1,7 +1,9 @@
 package projects
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"strings"
@@ -391,10 +393,65 @@ func (s *ProjectService) GetProgression(project *Project) (*Progression, error)
 	return resp.(*Progression), nil
 }
 
+// ExportProjects submits a project export and returns the ID of the server
+// task which performs it.
+//
+// Deprecated: Use projects.ExportProjects
+func (s *ProjectService) ExportProjects(command *ExportProjectsCommand) (*ExportProjectsResponse, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("ExportProjects", "command")
+	}
+
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("ExportProjects", err)
+	}
+
+	if internal.IsEmpty(s.exportProjectsPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), command, new(ExportProjectsResponse), s.exportProjectsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ExportProjectsResponse), nil
+}
+
+// ImportProjects submits a project import and returns the ID of the server
+// task which performs it.
+//
+// Deprecated: Use projects.ImportProjects
+func (s *ProjectService) ImportProjects(command *ImportProjectsCommand) (*ImportProjectsResponse, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("ImportProjects", "command")
+	}
+
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("ImportProjects", err)
+	}
+
+	if internal.IsEmpty(s.importProjectsPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), command, new(ImportProjectsResponse), s.importProjectsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*ImportProjectsResponse), nil
+}
+
 // ----- new -----
 
 // Add creates a new project.
 func Add(client newclient.Client, project *Project) (*Project, error) {
+	return AddWithContext(context.Background(), client, project)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, project *Project) (*Project, error) {
 	if IsNil(project) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterProject)
 	}
@@ -414,7 +471,7 @@ func Add(client newclient.Client, project *Project) (*Project, error) {
 		return nil, err
 	}
 
-	projectResponse, err := newclient.Post[Project](client.HttpSession(), expandedUri, project)
+	projectResponse, err := newclient.PostWithContext[Project](ctx, client.HttpSession(), expandedUri, project)
 	if err != nil {
 		return nil, err
 	}
@@ -426,6 +483,11 @@ func Add(client newclient.Client, project *Project) (*Project, error) {
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func Get(client newclient.Client, spaceID string, projectsQuery ProjectsQuery) (*resources.Resources[*Project], error) {
+	return GetWithContext(context.Background(), client, spaceID, projectsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, projectsQuery ProjectsQuery) (*resources.Resources[*Project], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -442,7 +504,7 @@ func Get(client newclient.Client, spaceID string, projectsQuery ProjectsQuery) (
 		return nil, err
 	}
 
-	resp, err := newclient.Get[resources.Resources[*Project]](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[resources.Resources[*Project]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*Project]{}, err
 	}
@@ -450,9 +512,26 @@ func Get(client newclient.Client, spaceID string, projectsQuery ProjectsQuery) (
 	return resp, nil
 }
 
+// Iterate returns an iterator over the projects matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query ProjectsQuery) iter.Seq2[*Project, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query ProjectsQuery) iter.Seq2[*Project, error] {
+	return newclient.IterateByQueryWithContext[Project](ctx, client, projectsTemplate, spaceID, query)
+}
+
 // GetByID returns the project that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (*Project, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (*Project, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -467,7 +546,7 @@ func GetByID(client newclient.Client, spaceID string, id string) (*Project, erro
 		"id":      id,
 	})
 
-	resp, err := newclient.Get[Project](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[Project](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return nil, err
 	}
@@ -479,6 +558,11 @@ func GetByID(client newclient.Client, spaceID string, id string) (*Project, erro
 // the default branch in the gitPersistenceSettings appears in the protected branch patterns, and will default to "octopus-vcs-conversion"
 // if not explicitly specified.
 func ConvertToVCS(client newclient.Client, project *Project, commitMessage string, initialCommitBranch string, gitPersistenceSettings GitPersistenceSettings) (*Project, error) {
+	return ConvertToVCSWithContext(context.Background(), client, project, commitMessage, initialCommitBranch, gitPersistenceSettings)
+}
+
+// ConvertToVCSWithContext is like ConvertToVCS, but uses ctx to control cancellation of the HTTP requests.
+func ConvertToVCSWithContext(ctx context.Context, client newclient.Client, project *Project, commitMessage string, initialCommitBranch string, gitPersistenceSettings GitPersistenceSettings) (*Project, error) {
 	if project == nil {
 		return nil, internal.CreateInvalidParameterError("ConvertToVcs", "project")
 	}
@@ -507,16 +591,21 @@ func ConvertToVCS(client newclient.Client, project *Project, commitMessage strin
 		"id":      project.ID,
 	})
 
-	_, err = newclient.Post[ConvertToVcsResponse](client.HttpSession(), expandedUri, convertToVcs)
+	_, err = newclient.PostWithContext[ConvertToVcsResponse](ctx, client.HttpSession(), expandedUri, convertToVcs)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetByID(client, spaceID, project.GetID())
+	return GetByIDWithContext(ctx, client, spaceID, project.GetID())
 }
 
 // Update modifies a project based on the one provided as input.
 func Update(client newclient.Client, project *Project) (*Project, error) {
+	return UpdateWithContext(context.Background(), client, project)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, project *Project) (*Project, error) {
 	if project == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, constants.ParameterProject)
 	}
@@ -536,7 +625,7 @@ func Update(client newclient.Client, project *Project) (*Project, error) {
 
 	project.Links = nil
 
-	resp, err := newclient.Put[Project](client.HttpSession(), expandedUri, project)
+	resp, err := newclient.PutWithContext[Project](ctx, client.HttpSession(), expandedUri, project)
 	if err != nil {
 		return nil, err
 	}
@@ -546,6 +635,11 @@ func Update(client newclient.Client, project *Project) (*Project, error) {
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -558,10 +652,15 @@ func DeleteByID(client newclient.Client, spaceID string, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
 
 // GetAll returns all projects. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*Project, error) {
-	return newclient.GetAll[Project](client, projectsTemplate, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*Project, error) {
+	return newclient.GetAllWithContext[Project](ctx, client, projectsTemplate, spaceID)
 }
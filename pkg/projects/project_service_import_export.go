@@ -0,0 +1,105 @@
+package projects
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+const (
+	exportProjectsTemplate        = "/api/{spaceId}/projects/import-export/export"
+	importProjectsTemplate        = "/api/{spaceId}/projects/import-export/import"
+	previewImportProjectsTemplate = "/api/{spaceId}/projects/import-export/import/preview"
+)
+
+// ExportProjects submits a project export and returns the ID of the server
+// task which performs it. Use RunExportProjects to also wait for the task.
+func ExportProjects(client newclient.Client, spaceID string, command *ExportProjectsCommand) (*ExportProjectsResponse, error) {
+	return ExportProjectsWithContext(context.Background(), client, spaceID, command)
+}
+
+// ExportProjectsWithContext is like ExportProjects, but uses ctx to control cancellation of the HTTP requests.
+func ExportProjectsWithContext(ctx context.Context, client newclient.Client, spaceID string, command *ExportProjectsCommand) (*ExportProjectsResponse, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("ExportProjects", "command")
+	}
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("ExportProjects", err)
+	}
+
+	return newclient.AddWithContext[ExportProjectsResponse](ctx, client, exportProjectsTemplate, spaceID, command)
+}
+
+// RunExportProjects submits a project export and waits for its server task to
+// complete. The archive is attached to the returned task, whose ID can be used
+// with NewImportProjectsCommand. If the task does not succeed, a
+// *tasks.TaskError is returned along with it.
+func RunExportProjects(client newclient.Client, spaceID string, command *ExportProjectsCommand, options *tasks.WaitOptions) (*tasks.Task, error) {
+	return RunExportProjectsWithContext(context.Background(), client, spaceID, command, options)
+}
+
+// RunExportProjectsWithContext is like RunExportProjects, but uses ctx to control cancellation of the HTTP requests.
+func RunExportProjectsWithContext(ctx context.Context, client newclient.Client, spaceID string, command *ExportProjectsCommand, options *tasks.WaitOptions) (*tasks.Task, error) {
+	response, err := ExportProjectsWithContext(ctx, client, spaceID, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.WaitForTaskWithContext(ctx, client, spaceID, response.TaskID, options)
+}
+
+// PreviewImportProjects returns what importing the projects into the space
+// would do, including how their dependencies will be matched with existing
+// resources, without performing the import.
+func PreviewImportProjects(client newclient.Client, spaceID string, command *ImportProjectsCommand) (*ImportProjectsPreview, error) {
+	return PreviewImportProjectsWithContext(context.Background(), client, spaceID, command)
+}
+
+// PreviewImportProjectsWithContext is like PreviewImportProjects, but uses ctx to control cancellation of the HTTP requests.
+func PreviewImportProjectsWithContext(ctx context.Context, client newclient.Client, spaceID string, command *ImportProjectsCommand) (*ImportProjectsPreview, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("PreviewImportProjects", "command")
+	}
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("PreviewImportProjects", err)
+	}
+
+	return newclient.AddWithContext[ImportProjectsPreview](ctx, client, previewImportProjectsTemplate, spaceID, command)
+}
+
+// ImportProjects submits a project import and returns the ID of the server
+// task which performs it. Use RunImportProjects to also wait for the task.
+func ImportProjects(client newclient.Client, spaceID string, command *ImportProjectsCommand) (*ImportProjectsResponse, error) {
+	return ImportProjectsWithContext(context.Background(), client, spaceID, command)
+}
+
+// ImportProjectsWithContext is like ImportProjects, but uses ctx to control cancellation of the HTTP requests.
+func ImportProjectsWithContext(ctx context.Context, client newclient.Client, spaceID string, command *ImportProjectsCommand) (*ImportProjectsResponse, error) {
+	if command == nil {
+		return nil, internal.CreateInvalidParameterError("ImportProjects", "command")
+	}
+	if err := command.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError("ImportProjects", err)
+	}
+
+	return newclient.AddWithContext[ImportProjectsResponse](ctx, client, importProjectsTemplate, spaceID, command)
+}
+
+// RunImportProjects submits a project import and waits for its server task to
+// complete. If the task does not succeed, a *tasks.TaskError is returned along
+// with it.
+func RunImportProjects(client newclient.Client, spaceID string, command *ImportProjectsCommand, options *tasks.WaitOptions) (*tasks.Task, error) {
+	return RunImportProjectsWithContext(context.Background(), client, spaceID, command, options)
+}
+
+// RunImportProjectsWithContext is like RunImportProjects, but uses ctx to control cancellation of the HTTP requests.
+func RunImportProjectsWithContext(ctx context.Context, client newclient.Client, spaceID string, command *ImportProjectsCommand, options *tasks.WaitOptions) (*tasks.Task, error) {
+	response, err := ImportProjectsWithContext(ctx, client, spaceID, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.WaitForTaskWithContext(ctx, client, spaceID, response.TaskID, options)
+}
@@ -0,0 +1,62 @@
+package projects
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExportProjectsWaitsForTask(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/Spaces-1/projects/import-export/export":
+			command := new(ExportProjectsCommand)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(command))
+			require.Equal(t, []string{"Projects-1"}, command.IncludedProjectIDs)
+			require.True(t, command.Password.HasValue)
+			_, _ = w.Write([]byte(`{"TaskId":"ServerTasks-1"}`))
+		case "/api/Spaces-1/tasks":
+			_, _ = w.Write([]byte(`{"Items":[{"Id":"ServerTasks-1","State":"Success"}]}`))
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	task, err := RunExportProjects(client, "Spaces-1", NewExportProjectsCommand("secret", "Projects-1"), &tasks.WaitOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, "ServerTasks-1", task.ID)
+}
+
+func TestExportProjectsRequiresProjects(t *testing.T) {
+	_, err := ExportProjects(newclient.NewClient(&newclient.HttpSession{}), "Spaces-1", NewExportProjectsCommand("secret"))
+	require.Error(t, err)
+}
+
+func TestPreviewImportProjectsReturnsDependencyMapping(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-2/projects/import-export/import/preview", r.URL.Path)
+		command := new(ImportProjectsCommand)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(command))
+		require.Equal(t, &ImportProjectsSource{SpaceID: "Spaces-1", TaskID: "ServerTasks-1", Type: ImportSourceTypeSpace}, command.ImportSource)
+		_, _ = w.Write([]byte(`{
+			"Projects": [{"Id": "Projects-1", "Name": "Web"}],
+			"Dependencies": [
+				{"Type": "Environment", "SourceId": "Environments-1", "SourceName": "Production", "DestinationId": "Environments-7", "DestinationName": "Production"},
+				{"Type": "Account", "SourceId": "Accounts-1", "SourceName": "Azure"}
+			]
+		}`))
+	}))
+
+	preview, err := PreviewImportProjects(client, "Spaces-2", NewImportProjectsCommand("Spaces-1", "ServerTasks-1", "secret"))
+	require.NoError(t, err)
+	require.Len(t, preview.Projects, 1)
+	require.Equal(t, map[string]string{"Environments-1": "Environments-7"}, preview.DependencyMapping())
+	require.Len(t, preview.UnmatchedDependencies(), 1)
+	require.Equal(t, "Accounts-1", preview.UnmatchedDependencies()[0].SourceID)
+}
@@ -0,0 +1,103 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ignoredFields are maintained by the server and never differ meaningfully.
+var ignoredFields = map[string]bool{
+	"LastModifiedBy": true,
+	"LastModifiedOn": true,
+	"Links":          true,
+}
+
+// Diff compares the JSON representations of two resources and returns the
+// fields whose values differ, ordered by path. Server-maintained fields such
+// as Links are ignored.
+func Diff(actual any, desired any) ([]FieldDiff, error) {
+	actualValue, err := toJSONValue(actual)
+	if err != nil {
+		return nil, err
+	}
+	desiredValue, err := toJSONValue(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []FieldDiff{}
+	diffValues("", actualValue, desiredValue, &diffs)
+	return diffs, nil
+}
+
+func toJSONValue(resource any) (any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffValues(path string, old any, new any, diffs *[]FieldDiff) {
+	oldObject, oldIsObject := old.(map[string]any)
+	newObject, newIsObject := new.(map[string]any)
+	if oldIsObject && newIsObject {
+		keys := map[string]bool{}
+		for key := range oldObject {
+			keys[key] = true
+		}
+		for key := range newObject {
+			keys[key] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			if !ignoredFields[key] {
+				sortedKeys = append(sortedKeys, key)
+			}
+		}
+		sort.Strings(sortedKeys)
+		for _, key := range sortedKeys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			diffValues(fieldPath, oldObject[key], newObject[key], diffs)
+		}
+		return
+	}
+
+	oldList, oldIsList := old.([]any)
+	newList, newIsList := new.([]any)
+	if oldIsList && newIsList && len(oldList) == len(newList) {
+		for i := range oldList {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i], diffs)
+		}
+		return
+	}
+
+	if isEmptyValue(old) && isEmptyValue(new) {
+		// omitted, null and empty collections are equivalent on the wire
+		return
+	}
+	if !reflect.DeepEqual(old, new) {
+		*diffs = append(*diffs, FieldDiff{Path: path, Old: old, New: new})
+	}
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
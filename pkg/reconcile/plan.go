@@ -0,0 +1,87 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Action is the operation a Change performs on a resource.
+type Action string
+
+const (
+	ActionCreate Action = "Create"
+	ActionDelete Action = "Delete"
+	ActionUpdate Action = "Update"
+)
+
+// FieldDiff is a difference in one field between an existing resource and
+// its desired state. Path uses dots for nested fields and brackets for list
+// elements, such as "Phases[0].OptionalDeploymentTargets".
+type FieldDiff struct {
+	Path string
+	Old  any
+	New  any
+}
+
+// Change is an operation which brings one resource into line with its
+// desired state.
+type Change struct {
+	Action Action
+	Kind   string
+	Name   string
+	// ID is the ID of the resource. For a create during a dry run, it is a
+	// placeholder of the form "<Kind/Name>".
+	ID string
+	// Diffs lists the fields which an update changes. It is empty for creates
+	// and deletes.
+	Diffs []FieldDiff
+	// Applied reports whether the change has been made on the server.
+	Applied bool
+}
+
+// Plan is the ordered list of changes needed to reconcile a space with its
+// desired state. Creates and updates are ordered so that resources are
+// written after the resources they depend on; deletes come last, in reverse
+// dependency order.
+type Plan struct {
+	Changes []*Change
+}
+
+// HasChanges reports whether the plan contains any changes.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// String renders the plan in a human-readable form, one change per line
+// followed by its field differences.
+func (p *Plan) String() string {
+	if !p.HasChanges() {
+		return "No changes."
+	}
+
+	var b strings.Builder
+	for _, change := range p.Changes {
+		symbol := map[Action]string{ActionCreate: "+", ActionDelete: "-", ActionUpdate: "~"}[change.Action]
+		fmt.Fprintf(&b, "%s %s %q", symbol, change.Kind, change.Name)
+		if change.ID != "" {
+			fmt.Fprintf(&b, " (%s)", change.ID)
+		}
+		b.WriteString("\n")
+		for _, diff := range change.Diffs {
+			fmt.Fprintf(&b, "    %s: %s => %s\n", diff.Path, formatValue(diff.Old), formatValue(diff.New))
+		}
+	}
+	return b.String()
+}
+
+func formatValue(value any) string {
+	if value == nil {
+		return "null"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,301 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// Reconciler brings the resources in a space into line with a desired state.
+//
+// Each kind of resource is registered along with its desired resources using
+// Register, or with Reference when resources of that kind are only referred to
+// by name and are not themselves managed. Plan then computes the changes
+// required without making them, and Apply makes them:
+//
+//	reconciler := reconcile.NewReconciler(client, spaceID)
+//	reconcile.Register(reconciler, reconcile.Environments(), production, staging)
+//	reconcile.Register(reconciler, reconcile.Lifecycles(), lifecycle)
+//	plan, err := reconciler.Plan(ctx)
+type Reconciler struct {
+	// Prune deletes existing resources of the kinds registered with Register
+	// which are not part of the desired state. Kinds registered with
+	// Reference are never pruned.
+	Prune bool
+
+	client  newclient.Client
+	spaceID string
+	kinds   []registeredKind
+}
+
+// registeredKind erases the type parameter of a ResourceType so that
+// resources of different kinds can be reconciled together.
+type registeredKind interface {
+	kind() string
+	dependsOn() []string
+	reconcile(ctx context.Context, r *Reconciler, refs *References, dryRun bool, plan *Plan) ([]pendingDelete, error)
+}
+
+type pendingDelete struct {
+	change *Change
+	delete func(ctx context.Context) error
+}
+
+// NewReconciler creates a reconciler for the space that matches the input ID.
+func NewReconciler(client newclient.Client, spaceID string) *Reconciler {
+	return &Reconciler{
+		client:  client,
+		spaceID: spaceID,
+	}
+}
+
+// Register adds a kind of resource to the reconciler, along with the desired
+// state of the resources of that kind which it manages. Existing resources
+// which are not in desired are left alone unless the reconciler's Prune is set.
+func Register[T any](r *Reconciler, resourceType *ResourceType[T], desired ...*T) error {
+	return register(r, resourceType, desired, true)
+}
+
+// Reference adds a kind of resource to the reconciler without managing any
+// resources of that kind, so that other resources can refer to them by name.
+func Reference[T any](r *Reconciler, resourceType *ResourceType[T]) error {
+	return register(r, resourceType, nil, false)
+}
+
+func register[T any](r *Reconciler, resourceType *ResourceType[T], desired []*T, managed bool) error {
+	if resourceType == nil {
+		return internal.CreateInvalidParameterError("Register", "resourceType")
+	}
+	if err := resourceType.validate(); err != nil {
+		return err
+	}
+	for _, existing := range r.kinds {
+		if existing.kind() == resourceType.Kind {
+			return fmt.Errorf("%s resources have already been registered with the reconciler", resourceType.Kind)
+		}
+	}
+
+	names := map[string]bool{}
+	for _, resource := range desired {
+		if resource == nil {
+			return internal.CreateInvalidParameterError("Register", "desired")
+		}
+		name := resourceType.Name(resource)
+		if name == "" {
+			return fmt.Errorf("cannot register a %s without a name", resourceType.Kind)
+		}
+		if names[name] {
+			return fmt.Errorf("the desired state contains more than one %s named %q", resourceType.Kind, name)
+		}
+		names[name] = true
+	}
+
+	r.kinds = append(r.kinds, &kindReconciler[T]{
+		resourceType: resourceType,
+		desired:      desired,
+		managed:      managed,
+	})
+	return nil
+}
+
+// Plan computes the changes needed to bring the space into line with the
+// desired state, without making them. Resources which would be created are
+// referred to by placeholder IDs in the differences of their dependents.
+func (r *Reconciler) Plan(ctx context.Context) (*Plan, error) {
+	return r.run(ctx, true)
+}
+
+// Apply makes the changes needed to bring the space into line with the
+// desired state, and returns them. Kinds are reconciled in dependency order,
+// so that IDs assigned to newly created resources can be referred to by the
+// resources that depend on them; deletes are made last, in reverse order. If
+// an error occurs, the changes made so far are returned along with it.
+func (r *Reconciler) Apply(ctx context.Context) (*Plan, error) {
+	return r.run(ctx, false)
+}
+
+func (r *Reconciler) run(ctx context.Context, dryRun bool) (*Plan, error) {
+	ordered, err := r.sortKinds()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Changes: []*Change{}}
+	refs := newReferences()
+	var deletes []pendingDelete
+	for _, kind := range ordered {
+		kindDeletes, err := kind.reconcile(ctx, r, refs, dryRun, plan)
+		if err != nil {
+			return plan, err
+		}
+		deletes = append(deletes, kindDeletes...)
+	}
+
+	// dependents are deleted before the resources they depend on
+	for i := len(deletes) - 1; i >= 0; i-- {
+		plan.Changes = append(plan.Changes, deletes[i].change)
+		if dryRun {
+			continue
+		}
+		if err := deletes[i].delete(ctx); err != nil {
+			return plan, err
+		}
+		deletes[i].change.Applied = true
+	}
+
+	return plan, nil
+}
+
+// sortKinds orders the registered kinds so that each comes after the kinds it
+// depends on, preserving registration order where there is no dependency.
+func (r *Reconciler) sortKinds() ([]registeredKind, error) {
+	byKind := map[string]registeredKind{}
+	for _, kind := range r.kinds {
+		byKind[kind.kind()] = kind
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	ordered := make([]registeredKind, 0, len(r.kinds))
+
+	var visit func(kind registeredKind, path []string) error
+	visit = func(kind registeredKind, path []string) error {
+		switch state[kind.kind()] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("resource kinds have a circular dependency: %v", append(path, kind.kind()))
+		}
+		state[kind.kind()] = visiting
+		dependencies := append([]string{}, kind.dependsOn()...)
+		sort.Strings(dependencies)
+		for _, dependency := range dependencies {
+			dependencyKind, ok := byKind[dependency]
+			if !ok {
+				return fmt.Errorf("%s resources depend on %s resources, which have not been registered with the reconciler", kind.kind(), dependency)
+			}
+			if err := visit(dependencyKind, append(path, kind.kind())); err != nil {
+				return err
+			}
+		}
+		state[kind.kind()] = visited
+		ordered = append(ordered, kind)
+		return nil
+	}
+
+	for _, kind := range r.kinds {
+		if err := visit(kind, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+type kindReconciler[T any] struct {
+	resourceType *ResourceType[T]
+	desired      []*T
+	managed      bool
+}
+
+func (k *kindReconciler[T]) kind() string {
+	return k.resourceType.Kind
+}
+
+func (k *kindReconciler[T]) dependsOn() []string {
+	return k.resourceType.DependsOn
+}
+
+func (k *kindReconciler[T]) reconcile(ctx context.Context, r *Reconciler, refs *References, dryRun bool, plan *Plan) ([]pendingDelete, error) {
+	t := k.resourceType
+
+	existing, err := t.List(ctx, r.client, r.spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list %s resources: %w", t.Kind, err)
+	}
+	actualByName := map[string]*T{}
+	for _, actual := range existing {
+		actualByName[t.Name(actual)] = actual
+		refs.set(t.Kind, t.Name(actual), t.ID(actual))
+	}
+
+	desiredNames := map[string]bool{}
+	for _, desired := range k.desired {
+		name := t.Name(desired)
+		desiredNames[name] = true
+		actual := actualByName[name]
+
+		prepared, err := t.Prepare(desired, actual, r.spaceID, refs)
+		if err != nil {
+			return nil, fmt.Errorf("cannot prepare %s %q: %w", t.Kind, name, err)
+		}
+
+		if actual == nil {
+			change := &Change{Action: ActionCreate, Kind: t.Kind, Name: name, ID: placeholderID(t.Kind, name)}
+			plan.Changes = append(plan.Changes, change)
+			refs.set(t.Kind, name, change.ID)
+			if dryRun {
+				continue
+			}
+			created, err := t.Add(ctx, r.client, prepared)
+			if err != nil {
+				return nil, fmt.Errorf("cannot create %s %q: %w", t.Kind, name, err)
+			}
+			change.ID = t.ID(created)
+			change.Applied = true
+			refs.set(t.Kind, name, change.ID)
+			continue
+		}
+
+		diffs, err := Diff(actual, prepared)
+		if err != nil {
+			return nil, err
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		change := &Change{Action: ActionUpdate, Kind: t.Kind, Name: name, ID: t.ID(actual), Diffs: diffs}
+		plan.Changes = append(plan.Changes, change)
+		if dryRun {
+			continue
+		}
+		if _, err := t.Update(ctx, r.client, prepared); err != nil {
+			return nil, fmt.Errorf("cannot update %s %q: %w", t.Kind, name, err)
+		}
+		change.Applied = true
+	}
+
+	if !k.managed || !r.Prune {
+		return nil, nil
+	}
+
+	var deletes []pendingDelete
+	for _, actual := range existing {
+		name := t.Name(actual)
+		if desiredNames[name] {
+			continue
+		}
+		ID := t.ID(actual)
+		refs.remove(t.Kind, name)
+		deletes = append(deletes, pendingDelete{
+			change: &Change{Action: ActionDelete, Kind: t.Kind, Name: name, ID: ID},
+			delete: func(ctx context.Context) error {
+				if err := t.Delete(ctx, r.client, r.spaceID, ID); err != nil {
+					return fmt.Errorf("cannot delete %s %q: %w", t.Kind, name, err)
+				}
+				return nil
+			},
+		})
+	}
+	return deletes, nil
+}
+
+func errMissingField(field string, kind string) error {
+	return fmt.Errorf("the resource type for %q must set %s", kind, field)
+}
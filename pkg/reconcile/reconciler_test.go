@@ -0,0 +1,213 @@
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSpace is an in-memory stand-in for the collections of a space.
+type fakeSpace struct {
+	mu          sync.Mutex
+	collections map[string]map[string]map[string]any
+	nextID      int
+	writes      []string
+}
+
+func newFakeSpace() *fakeSpace {
+	return &fakeSpace{collections: map[string]map[string]map[string]any{}}
+}
+
+func (f *fakeSpace) add(collection string, prefix string, resource map[string]any) string {
+	f.nextID++
+	ID := fmt.Sprintf("%s-%d", prefix, f.nextID)
+	resource["Id"] = ID
+	if f.collections[collection] == nil {
+		f.collections[collection] = map[string]map[string]any{}
+	}
+	f.collections[collection][ID] = resource
+	return ID
+}
+
+func (f *fakeSpace) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/Spaces-1/"), "/")
+	collection := parts[0]
+	prefix := map[string]string{"environments": "Environments", "lifecycles": "Lifecycles"}[collection]
+
+	switch {
+	case r.Method == http.MethodGet && len(parts) == 1:
+		IDs := make([]string, 0, len(f.collections[collection]))
+		for ID := range f.collections[collection] {
+			IDs = append(IDs, ID)
+		}
+		sort.Strings(IDs)
+		items := make([]map[string]any, 0, len(IDs))
+		for _, ID := range IDs {
+			items = append(items, f.collections[collection][ID])
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Items": items})
+	case r.Method == http.MethodPost && len(parts) == 1:
+		resource := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&resource)
+		f.add(collection, prefix, resource)
+		f.writes = append(f.writes, "POST "+collection+" "+resource["Name"].(string))
+		_ = json.NewEncoder(w).Encode(resource)
+	case r.Method == http.MethodPut && len(parts) == 2:
+		resource := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&resource)
+		f.collections[collection][parts[1]] = resource
+		f.writes = append(f.writes, "PUT "+parts[1])
+		_ = json.NewEncoder(w).Encode(resource)
+	case r.Method == http.MethodDelete && len(parts) == 2:
+		delete(f.collections[collection], parts[1])
+		f.writes = append(f.writes, "DELETE "+parts[1])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"not found"}`))
+	}
+}
+
+func newDesiredLifecycle(name string, environmentNames ...string) *lifecycles.Lifecycle {
+	lifecycle := lifecycles.NewLifecycle(name)
+	phase := lifecycles.NewPhase("Release")
+	phase.OptionalDeploymentTargets = environmentNames
+	lifecycle.Phases = []*lifecycles.Phase{phase}
+	return lifecycle
+}
+
+func TestPlanDoesNotWrite(t *testing.T) {
+	space := newFakeSpace()
+	space.add("environments", "Environments", map[string]any{"Name": "Staging", "Slug": "staging", "SortOrder": 1, "SpaceId": "Spaces-1"})
+	client := testutil.NewTestClient(t, space)
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	require.NoError(t, Register(reconciler, Environments(), environments.NewEnvironment("Staging"), environments.NewEnvironment("Production")))
+	require.NoError(t, Register(reconciler, Lifecycles(), newDesiredLifecycle("Default", "Staging", "Production")))
+
+	plan, err := reconciler.Plan(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, space.writes)
+
+	require.Len(t, plan.Changes, 2)
+	require.Equal(t, &Change{Action: ActionCreate, Kind: KindEnvironment, Name: "Production", ID: "<Environment/Production>"}, plan.Changes[0])
+	require.Equal(t, ActionCreate, plan.Changes[1].Action)
+	require.Equal(t, KindLifecycle, plan.Changes[1].Kind)
+}
+
+func TestApplyCreatesInDependencyOrderAndResolvesNames(t *testing.T) {
+	space := newFakeSpace()
+	client := testutil.NewTestClient(t, space)
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	// registered out of order; the reconciler must still create environments first
+	require.NoError(t, Register(reconciler, Lifecycles(), newDesiredLifecycle("Default", "Staging")))
+	require.NoError(t, Register(reconciler, Environments(), environments.NewEnvironment("Staging")))
+
+	plan, err := reconciler.Apply(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"POST environments Staging", "POST lifecycles Default"}, space.writes)
+	require.True(t, plan.Changes[0].Applied)
+	require.Equal(t, "Environments-1", plan.Changes[0].ID)
+
+	lifecycle := space.collections["lifecycles"]["Lifecycles-2"]
+	phase := lifecycle["Phases"].([]any)[0].(map[string]any)
+	require.Equal(t, []any{"Environments-1"}, phase["OptionalDeploymentTargets"])
+
+	// a second run has nothing to do
+	space.writes = nil
+	plan, err = reconciler.Apply(context.Background())
+	require.NoError(t, err)
+	require.False(t, plan.HasChanges(), plan.String())
+	require.Empty(t, space.writes)
+}
+
+func TestApplyUpdatesWithFieldDiffs(t *testing.T) {
+	space := newFakeSpace()
+	space.add("environments", "Environments", map[string]any{"Name": "Production", "Slug": "production", "SortOrder": 2, "SpaceId": "Spaces-1"})
+	client := testutil.NewTestClient(t, space)
+
+	desired := environments.NewEnvironment("Production")
+	desired.UseGuidedFailure = true
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	require.NoError(t, Register(reconciler, Environments(), desired))
+
+	plan, err := reconciler.Apply(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.Equal(t, ActionUpdate, plan.Changes[0].Action)
+	require.Equal(t, []FieldDiff{{Path: "UseGuidedFailure", Old: false, New: true}}, plan.Changes[0].Diffs)
+	require.Equal(t, []string{"PUT Environments-1"}, space.writes)
+	require.Equal(t, "production", space.collections["environments"]["Environments-1"]["Slug"])
+}
+
+func TestApplyPrunesDependentsFirst(t *testing.T) {
+	space := newFakeSpace()
+	environmentID := space.add("environments", "Environments", map[string]any{"Name": "Old", "Slug": "old", "SpaceId": "Spaces-1"})
+	lifecycleID := space.add("lifecycles", "Lifecycles", map[string]any{"Name": "Old", "SpaceId": "Spaces-1"})
+	client := testutil.NewTestClient(t, space)
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	reconciler.Prune = true
+	require.NoError(t, Register[environments.Environment](reconciler, Environments()))
+	require.NoError(t, Register[lifecycles.Lifecycle](reconciler, Lifecycles()))
+
+	plan, err := reconciler.Apply(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+	require.Equal(t, []string{"DELETE " + lifecycleID, "DELETE " + environmentID}, space.writes)
+}
+
+func TestReferencedKindsAreNotPruned(t *testing.T) {
+	space := newFakeSpace()
+	space.add("environments", "Environments", map[string]any{"Name": "Staging", "Slug": "staging", "SpaceId": "Spaces-1"})
+	client := testutil.NewTestClient(t, space)
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	reconciler.Prune = true
+	require.NoError(t, Reference(reconciler, Environments()))
+	require.NoError(t, Register(reconciler, Lifecycles(), newDesiredLifecycle("Default", "Staging")))
+
+	plan, err := reconciler.Plan(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.Equal(t, ActionCreate, plan.Changes[0].Action)
+}
+
+func TestPlanFailsForUnknownReferences(t *testing.T) {
+	client := testutil.NewTestClient(t, newFakeSpace())
+
+	reconciler := NewReconciler(client, "Spaces-1")
+	require.NoError(t, Reference(reconciler, Environments()))
+	require.NoError(t, Register(reconciler, Lifecycles(), newDesiredLifecycle("Default", "Nowhere")))
+
+	_, err := reconciler.Plan(context.Background())
+	require.ErrorContains(t, err, `cannot resolve Environment "Nowhere"`)
+}
+
+func TestPlanFailsForUnregisteredDependencies(t *testing.T) {
+	reconciler := NewReconciler(testutil.NewTestClient(t, newFakeSpace()), "Spaces-1")
+	require.NoError(t, Register(reconciler, Lifecycles(), newDesiredLifecycle("Default")))
+
+	_, err := reconciler.Plan(context.Background())
+	require.ErrorContains(t, err, "have not been registered")
+}
+
+func TestRegisterRejectsDuplicateNames(t *testing.T) {
+	reconciler := NewReconciler(nil, "Spaces-1")
+	err := Register(reconciler, Environments(), environments.NewEnvironment("Staging"), environments.NewEnvironment("Staging"))
+	require.Error(t, err)
+}
@@ -0,0 +1,78 @@
+package reconcile
+
+import (
+	"fmt"
+	"sort"
+)
+
+// References resolves the names of resources to their IDs. It is populated
+// as each kind of resource is reconciled, and is passed to ResourceType.Prepare
+// so that resources can refer to the resources they depend on by name.
+type References struct {
+	ids map[string]map[string]string
+}
+
+func newReferences() *References {
+	return &References{ids: map[string]map[string]string{}}
+}
+
+// ID returns the ID of the resource of the given kind which has the given
+// name. For convenience, the ID of an existing resource is also accepted and
+// returned unchanged. During a dry run, resources which would be created are
+// given a placeholder ID of the form "<Kind/Name>".
+func (r *References) ID(kind string, nameOrID string) (string, error) {
+	ids, ok := r.ids[kind]
+	if !ok {
+		return "", fmt.Errorf("cannot resolve %s %q; %s resources have not been registered with the reconciler", kind, nameOrID, kind)
+	}
+	if ID, ok := ids[nameOrID]; ok {
+		return ID, nil
+	}
+	for _, ID := range ids {
+		if ID == nameOrID {
+			return ID, nil
+		}
+	}
+	return "", fmt.Errorf("cannot resolve %s %q; no %s has that name or ID", kind, nameOrID, kind)
+}
+
+// IDs resolves each of the input names or IDs, as ID does.
+func (r *References) IDs(kind string, namesOrIDs []string) ([]string, error) {
+	if namesOrIDs == nil {
+		return nil, nil
+	}
+	IDs := make([]string, len(namesOrIDs))
+	for i, nameOrID := range namesOrIDs {
+		ID, err := r.ID(kind, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		IDs[i] = ID
+	}
+	return IDs, nil
+}
+
+// Names returns the names of the known resources of the given kind, sorted.
+func (r *References) Names(kind string) []string {
+	names := make([]string, 0, len(r.ids[kind]))
+	for name := range r.ids[kind] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *References) set(kind string, name string, ID string) {
+	if r.ids[kind] == nil {
+		r.ids[kind] = map[string]string{}
+	}
+	r.ids[kind][name] = ID
+}
+
+func (r *References) remove(kind string, name string) {
+	delete(r.ids[kind], name)
+}
+
+func placeholderID(kind string, name string) string {
+	return "<" + kind + "/" + name + ">"
+}
@@ -0,0 +1,66 @@
+package reconcile
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// ResourceType describes how to read, compare and write one kind of resource,
+// so that a Reconciler can bring the resources of that kind in a space into
+// line with a desired state. Resources are identified by name within their
+// kind.
+type ResourceType[T any] struct {
+	// Kind names the type of resource, such as "Environment". Other resource
+	// types refer to it by this name in DependsOn and References.ID.
+	Kind string
+
+	// DependsOn lists the kinds of resource which resources of this kind refer
+	// to. They are reconciled first, so that their IDs can be resolved.
+	DependsOn []string
+
+	// Name returns the name which identifies the resource within its kind.
+	Name func(resource *T) string
+
+	// ID returns the ID assigned to the resource by the server.
+	ID func(resource *T) string
+
+	// List returns every existing resource of this kind in the space.
+	List func(ctx context.Context, client newclient.Client, spaceID string) ([]*T, error)
+
+	// Add creates the resource.
+	Add func(ctx context.Context, client newclient.Client, resource *T) (*T, error)
+
+	// Update replaces the existing resource with the one provided.
+	Update func(ctx context.Context, client newclient.Client, resource *T) (*T, error)
+
+	// Delete deletes the resource that matches the input ID.
+	Delete func(ctx context.Context, client newclient.Client, spaceID string, ID string) error
+
+	// Prepare returns a copy of desired which is ready to be sent to the
+	// server: references to other resources are resolved to IDs through refs
+	// and, when actual is not nil, fields assigned by the server (such as the
+	// ID) are copied from actual so that they don't show up as differences.
+	// Prepare must not modify desired or actual.
+	Prepare func(desired *T, actual *T, spaceID string, refs *References) (*T, error)
+}
+
+func (t *ResourceType[T]) validate() error {
+	if t.Kind == "" {
+		return errMissingField("Kind", t.Kind)
+	}
+	for name, set := range map[string]bool{
+		"Name":    t.Name != nil,
+		"ID":      t.ID != nil,
+		"List":    t.List != nil,
+		"Add":     t.Add != nil,
+		"Update":  t.Update != nil,
+		"Delete":  t.Delete != nil,
+		"Prepare": t.Prepare != nil,
+	} {
+		if !set {
+			return errMissingField(name, t.Kind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package reconcile
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projectgroups"
+)
+
+const (
+	KindEnvironment  = "Environment"
+	KindLifecycle    = "Lifecycle"
+	KindProjectGroup = "ProjectGroup"
+)
+
+// Environments returns the resource type for environments.
+//
+// A desired environment which leaves Slug or SortOrder unset keeps the value
+// assigned by the server.
+func Environments() *ResourceType[environments.Environment] {
+	return &ResourceType[environments.Environment]{
+		Kind:   KindEnvironment,
+		Name:   func(environment *environments.Environment) string { return environment.Name },
+		ID:     func(environment *environments.Environment) string { return environment.GetID() },
+		List:   environments.GetAllWithContext,
+		Add:    environments.AddWithContext,
+		Update: environments.UpdateWithContext,
+		Delete: environments.DeleteByIDWithContext,
+		Prepare: func(desired *environments.Environment, actual *environments.Environment, spaceID string, refs *References) (*environments.Environment, error) {
+			prepared := *desired
+			prepared.SpaceID = spaceID
+			if actual != nil {
+				prepared.Resource = actual.Resource
+				if prepared.Slug == "" {
+					prepared.Slug = actual.Slug
+				}
+				if prepared.SortOrder == 0 {
+					prepared.SortOrder = actual.SortOrder
+				}
+			}
+			return &prepared, nil
+		},
+	}
+}
+
+// ProjectGroups returns the resource type for project groups.
+func ProjectGroups() *ResourceType[projectgroups.ProjectGroup] {
+	return &ResourceType[projectgroups.ProjectGroup]{
+		Kind: KindProjectGroup,
+		Name: func(projectGroup *projectgroups.ProjectGroup) string { return projectGroup.Name },
+		ID:   func(projectGroup *projectgroups.ProjectGroup) string { return projectGroup.GetID() },
+		List: projectgroups.GetAllWithContext,
+		Add:  projectgroups.AddWithContext,
+		Update: func(ctx context.Context, client newclient.Client, projectGroup *projectgroups.ProjectGroup) (*projectgroups.ProjectGroup, error) {
+			return projectgroups.UpdateWithContext(ctx, client, *projectGroup)
+		},
+		Delete: projectgroups.DeleteByIDWithContext,
+		Prepare: func(desired *projectgroups.ProjectGroup, actual *projectgroups.ProjectGroup, spaceID string, refs *References) (*projectgroups.ProjectGroup, error) {
+			prepared := *desired
+			prepared.SpaceID = spaceID
+			if actual != nil {
+				prepared.Resource = actual.Resource
+				if prepared.RetentionPolicyID == "" {
+					prepared.RetentionPolicyID = actual.RetentionPolicyID
+				}
+			}
+			return &prepared, nil
+		},
+	}
+}
+
+// Lifecycles returns the resource type for lifecycles. It depends on
+// environments, so Environments must also be registered with the reconciler.
+//
+// The AutomaticDeploymentTargets and OptionalDeploymentTargets of each phase
+// of a desired lifecycle may refer to environments by name. Phases are matched
+// with existing phases by name, and a desired lifecycle which leaves its
+// retention policies unset keeps the existing ones.
+func Lifecycles() *ResourceType[lifecycles.Lifecycle] {
+	return &ResourceType[lifecycles.Lifecycle]{
+		Kind:      KindLifecycle,
+		DependsOn: []string{KindEnvironment},
+		Name:      func(lifecycle *lifecycles.Lifecycle) string { return lifecycle.Name },
+		ID:        func(lifecycle *lifecycles.Lifecycle) string { return lifecycle.GetID() },
+		List:      lifecycles.GetAllWithContext,
+		Add:       lifecycles.AddWithContext,
+		Update:    lifecycles.UpdateWithContext,
+		Delete:    lifecycles.DeleteByIDWithContext,
+		Prepare:   prepareLifecycle,
+	}
+}
+
+func prepareLifecycle(desired *lifecycles.Lifecycle, actual *lifecycles.Lifecycle, spaceID string, refs *References) (*lifecycles.Lifecycle, error) {
+	prepared := *desired
+	prepared.SpaceID = spaceID
+
+	actualPhases := map[string]*lifecycles.Phase{}
+	if actual != nil {
+		prepared.Resource = actual.Resource
+		if prepared.ReleaseRetentionPolicy == nil {
+			prepared.ReleaseRetentionPolicy = actual.ReleaseRetentionPolicy
+		}
+		if prepared.TentacleRetentionPolicy == nil {
+			prepared.TentacleRetentionPolicy = actual.TentacleRetentionPolicy
+		}
+		for _, phase := range actual.Phases {
+			actualPhases[phase.Name] = phase
+		}
+	}
+
+	prepared.Phases = make([]*lifecycles.Phase, len(desired.Phases))
+	for i, desiredPhase := range desired.Phases {
+		phase := *desiredPhase
+
+		var err error
+		if phase.AutomaticDeploymentTargets, err = refs.IDs(KindEnvironment, desiredPhase.AutomaticDeploymentTargets); err != nil {
+			return nil, err
+		}
+		if phase.OptionalDeploymentTargets, err = refs.IDs(KindEnvironment, desiredPhase.OptionalDeploymentTargets); err != nil {
+			return nil, err
+		}
+
+		if actualPhase, ok := actualPhases[phase.Name]; ok {
+			phase.ID = actualPhase.ID
+			if phase.ReleaseRetentionPolicy == nil {
+				phase.ReleaseRetentionPolicy = actualPhase.ReleaseRetentionPolicy
+			}
+			if phase.TentacleRetentionPolicy == nil {
+				phase.TentacleRetentionPolicy = actualPhase.TentacleRetentionPolicy
+			}
+		}
+		prepared.Phases[i] = &phase
+	}
+
+	return &prepared, nil
+}
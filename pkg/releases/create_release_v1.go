@@ -1,6 +1,7 @@
 package releases
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -51,6 +52,11 @@ func (c *CreateReleaseCommandV1) MarshalJSON() ([]byte, error) {
 }
 
 func CreateReleaseV1(client newclient.Client, command *CreateReleaseCommandV1) (*CreateReleaseResponseV1, error) {
+	return CreateReleaseV1WithContext(context.Background(), client, command)
+}
+
+// CreateReleaseV1WithContext is like CreateReleaseV1, but uses ctx to control cancellation of the HTTP requests.
+func CreateReleaseV1WithContext(ctx context.Context, client newclient.Client, command *CreateReleaseCommandV1) (*CreateReleaseResponseV1, error) {
 	if command == nil {
 		return nil, internal.CreateInvalidParameterError("CreateReleaseV1", "command")
 	}
@@ -64,5 +70,5 @@ func CreateReleaseV1(client newclient.Client, command *CreateReleaseCommandV1) (
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Post[CreateReleaseResponseV1](client.HttpSession(), path, command)
+	return newclient.PostWithContext[CreateReleaseResponseV1](ctx, client.HttpSession(), path, command)
 }
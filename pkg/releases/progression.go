@@ -31,8 +31,16 @@ type LifecycleProgressionPhase struct {
 // PhaseDeployment represents a deployment as part of a progression phase
 type PhaseDeployment struct {
 	Task *tasks.Task `json:"Task"`
-	// If we uncomment this line we get an import cycle releases -> deployments -> releases
-	// Deployment *Deployment `json:"Deployment"`
+	// The full deployments.Deployment would give us an import cycle releases -> deployments -> releases,
+	// so only the fields needed to follow the progression are read
+	Deployment *PhaseDeploymentSummary `json:"Deployment"`
+}
+
+// PhaseDeploymentSummary identifies the deployment of a release to an environment within a progression phase
+type PhaseDeploymentSummary struct {
+	ID            string `json:"Id"`
+	EnvironmentID string `json:"EnvironmentId"`
+	TenantID      string `json:"TenantId,omitempty"`
 }
 
 type PhaseProgress string
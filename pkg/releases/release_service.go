@@ -1,6 +1,9 @@
 package releases
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/channels"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
@@ -114,50 +117,103 @@ func (s *ReleaseService) GetReleases(channel *channels.Channel, releaseQuery ...
 	return resp.(*resources.Resources[*Release]), nil
 }
 
-// ----- Experimental ---------------------------------------------------------
+// GetProgression returns the progression of a release through the phases of
+// its channel's lifecycle, including the environments it can be deployed to
+// next.
+func GetProgression(client newclient.Client, spaceID string, releaseID string) (*LifecycleProgression, error) {
+	return GetProgressionWithContext(context.Background(), client, spaceID, releaseID)
+}
 
-// GetReleasesInProjectChannel is EXPERIMENTAL
-func GetReleasesInProjectChannel(client newclient.Client, spaceID string, projectID string, channelID string) ([]*Release, error) {
+// GetProgressionWithContext is like GetProgression, but uses ctx to control cancellation of the HTTP requests.
+func GetProgressionWithContext(ctx context.Context, client newclient.Client, spaceID string, releaseID string) (*LifecycleProgression, error) {
 	if client == nil {
-		return nil, internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "client")
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
 	}
-	if projectID == "" {
-		return nil, internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "project")
-	}
-	if channelID == "" {
-		return nil, internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "channel")
+	if internal.IsEmpty(releaseID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("releaseID")
 	}
-	if spaceID == "" {
-		return nil, internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "spaceID")
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
 	}
 
-	expandedUri, err := client.URITemplateCache().Expand(uritemplates.ReleasesByProjectAndChannel, map[string]any{
-		"spaceId":   spaceID,
-		"projectId": projectID,
-		"channelId": channelID,
+	expandedUri, err := client.URITemplateCache().Expand(uritemplates.ReleaseProgression, map[string]any{
+		"spaceId": spaceID,
+		"id":      releaseID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	return newclient.GetWithContext[LifecycleProgression](ctx, client.HttpSession(), expandedUri)
+}
 
-	loadNextPage := true
+// Iterate returns an iterator over the releases matching the criteria defined
+// by its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query ReleasesQuery) iter.Seq2[*Release, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
 
-	var allResults []*Release
-	for loadNextPage { // can't stop the loop
-		resp, err := newclient.Get[resources.Resources[*Release]](client.HttpSession(), expandedUri)
-		if err != nil {
-			return nil, err
-		}
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query ReleasesQuery) iter.Seq2[*Release, error] {
+	return newclient.IterateByQueryWithContext[Release](ctx, client, uritemplates.Releases, spaceID, query)
+}
 
-		allResults = append(allResults, resp.Items...)
-		expandedUri, loadNextPage = services.LoadNextPage(resp.PagedResults)
+// ----- Experimental ---------------------------------------------------------
+
+// releasesInProjectChannelQuery fills the parameters of the
+// ReleasesByProjectAndChannel template.
+type releasesInProjectChannelQuery struct {
+	ChannelID string `uri:"channelId"`
+	ProjectID string `uri:"projectId"`
+}
+
+// GetReleasesInProjectChannel is EXPERIMENTAL
+func GetReleasesInProjectChannel(client newclient.Client, spaceID string, projectID string, channelID string) ([]*Release, error) {
+	return GetReleasesInProjectChannelWithContext(context.Background(), client, spaceID, projectID, channelID)
+}
+
+// GetReleasesInProjectChannelWithContext is like GetReleasesInProjectChannel, but uses ctx to control cancellation of the HTTP requests.
+func GetReleasesInProjectChannelWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, channelID string) ([]*Release, error) {
+	return newclient.Collect(IterateInProjectChannelWithContext(ctx, client, spaceID, projectID, channelID))
+}
+
+// IterateInProjectChannel is EXPERIMENTAL. It is like GetReleasesInProjectChannel,
+// but returns an iterator which requests pages lazily as the iteration proceeds.
+func IterateInProjectChannel(client newclient.Client, spaceID string, projectID string, channelID string) iter.Seq2[*Release, error] {
+	return IterateInProjectChannelWithContext(context.Background(), client, spaceID, projectID, channelID)
+}
+
+// IterateInProjectChannelWithContext is like IterateInProjectChannel, but uses ctx to control cancellation of the HTTP requests.
+func IterateInProjectChannelWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, channelID string) iter.Seq2[*Release, error] {
+	var err error
+	switch {
+	case client == nil:
+		err = internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "client")
+	case projectID == "":
+		err = internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "project")
+	case channelID == "":
+		err = internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "channel")
+	case spaceID == "":
+		err = internal.CreateInvalidParameterError("GetReleasesInProjectChannel", "spaceID")
+	}
+	if err != nil {
+		return func(yield func(*Release, error) bool) {
+			yield(nil, err)
+		}
 	}
 
-	return allResults, nil
+	query := releasesInProjectChannelQuery{ChannelID: channelID, ProjectID: projectID}
+	return newclient.IterateByQueryWithContext[Release](ctx, client, uritemplates.ReleasesByProjectAndChannel, spaceID, query)
 }
 
 // GetReleaseInProject looks up a single release in the given project
 func GetReleaseInProject(client newclient.Client, spaceID string, projectID string, releaseVersion string) (*Release, error) {
+	return GetReleaseInProjectWithContext(context.Background(), client, spaceID, projectID, releaseVersion)
+}
+
+// GetReleaseInProjectWithContext is like GetReleaseInProject, but uses ctx to control cancellation of the HTTP requests.
+func GetReleaseInProjectWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, releaseVersion string) (*Release, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetReleasesForChannel", "client")
 	}
@@ -179,5 +235,5 @@ func GetReleaseInProject(client newclient.Client, spaceID string, projectID stri
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[Release](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[Release](ctx, client.HttpSession(), expandedUri)
 }
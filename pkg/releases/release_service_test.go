@@ -0,0 +1,51 @@
+package releases
+
+import (
+	"net/http"
+	"testing"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterate(t *testing.T) {
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/releases", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Equal(t, "1", r.URL.Query().Get("take"))
+		if r.URL.Query().Get("skip") == "" {
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "Releases-1"}], "Links": {"Page.Next": "/api/Spaces-1/releases?skip=1&take=1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Releases-2"}], "Links": {}}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	for release, err := range Iterate(client, "", ReleasesQuery{Take: 1}) {
+		require.NoError(t, err)
+		require.Equal(t, "Releases-1", release.GetID())
+		break
+	}
+	require.Equal(t, 1, requests, "breaking out early stops the next page from being requested")
+}
+
+func TestGetReleasesInProjectChannel(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/projects/Projects-1/channels/Channels-1/releases", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("skip") == "" {
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "Releases-2"}], "Links": {"Page.Next": "/api/Spaces-1/projects/Projects-1/channels/Channels-1/releases?skip=1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Releases-1"}], "Links": {}}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	releases, err := GetReleasesInProjectChannel(client, "Spaces-1", "Projects-1", "Channels-1")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	require.Equal(t, "Releases-1", releases[1].GetID())
+
+	_, err = GetReleasesInProjectChannel(client, "Spaces-1", "Projects-1", "")
+	require.Error(t, err)
+}
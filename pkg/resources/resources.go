@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
 )
@@ -33,16 +35,36 @@ func (r *Resources[T]) GetNextPage(client *sling.Sling) (*Resources[T], error) {
 // from the base Resource.
 func (r *Resources[T]) GetAllPages(client *sling.Sling) ([]T, error) {
 	items := make([]T, 0)
-	res := r
-	var err error
-	for res != nil {
-		items = append(items, res.Items...)
-		res, err = res.GetNextPage(client)
+	for item, err := range r.Iterate(client) {
 		if err != nil {
 			return nil, err
 		}
+		items = append(items, item)
 	}
 	return items, nil
 }
 
+// Iterate returns an iterator over the Items of the base Resource followed by
+// those of every remaining next page in the link collection. Pages are
+// retrieved lazily as the iteration proceeds.
+func (r *Resources[T]) Iterate(client *sling.Sling) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		res := r
+		var err error
+		for res != nil {
+			for _, item := range res.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			res, err = res.GetNextPage(client)
+			if err != nil {
+				var empty T
+				yield(empty, err)
+				return
+			}
+		}
+	}
+}
+
 var _ IResources[any] = &Resources[any]{}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagedClient returns a client for a collection of count IDs, served in
+// pages of the size requested by take, and a pointer to the number of pages
+// which have been requested.
+func newPagedClient(t *testing.T, count int) (*sling.Sling, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		take, err := strconv.Atoi(r.URL.Query().Get("take"))
+		require.NoError(t, err)
+
+		items := ""
+		for i := skip; i < min(skip+take, count); i++ {
+			if i > skip {
+				items += ","
+			}
+			items += fmt.Sprintf(`"Things-%d"`, i+1)
+		}
+		next := ""
+		if skip+take < count {
+			next = fmt.Sprintf("/api/things?skip=%d&take=%d", skip+take, take)
+		}
+		_, _ = fmt.Fprintf(w, `{"Items": [%s], "ItemsPerPage": %d, "Links": {"Page.Next": %q}}`, items, take, next)
+	}))
+	t.Cleanup(server.Close)
+
+	return sling.New().Base(server.URL).Client(server.Client()), &requests
+}
+
+func firstPage(t *testing.T, client *sling.Sling, take int) *Resources[string] {
+	first := new(Resources[string])
+	_, err := client.New().Get(fmt.Sprintf("/api/things?take=%d", take)).ReceiveSuccess(first)
+	require.NoError(t, err)
+	return first
+}
+
+func TestGetAllPages(t *testing.T) {
+	client, requests := newPagedClient(t, 5)
+
+	items, err := firstPage(t, client, 2).GetAllPages(client)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Things-1", "Things-2", "Things-3", "Things-4", "Things-5"}, items)
+	require.Equal(t, 3, *requests)
+}
+
+func TestIterateStopsRequestingPagesWhenBroken(t *testing.T) {
+	client, requests := newPagedClient(t, 5)
+	first := firstPage(t, client, 2)
+
+	items := []string{}
+	for item, err := range first.Iterate(client) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if len(items) == 2 {
+			break
+		}
+	}
+	require.Equal(t, []string{"Things-1", "Things-2"}, items)
+	require.Equal(t, 1, *requests, "only the first page should be requested")
+}
+
+func TestIterateUsesPageSizeOfFirstPage(t *testing.T) {
+	client, requests := newPagedClient(t, 5)
+
+	count := 0
+	for _, err := range firstPage(t, client, 3).Iterate(client) {
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 5, count)
+	require.Equal(t, 2, *requests)
+}
@@ -1,6 +1,7 @@
 package runbookprocess
 
 import (
+	"context"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 )
 
@@ -9,10 +10,20 @@ const template = "/api/{spaceId}/runbookProcesses{/id}{?skip,take,ids}"
 // GetByID returns the runbook process that matches the input ID. If one cannot
 // be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*RunbookProcess, error) {
-	return newclient.GetByID[RunbookProcess](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*RunbookProcess, error) {
+	return newclient.GetByIDWithContext[RunbookProcess](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a runbook process based on the one provided as input.
 func Update(client newclient.Client, runbook *RunbookProcess) (*RunbookProcess, error) {
-	return newclient.Update[RunbookProcess](client, template, runbook.SpaceID, runbook.ID, runbook)
+	return UpdateWithContext(context.Background(), client, runbook)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, runbook *RunbookProcess) (*RunbookProcess, error) {
+	return newclient.UpdateWithContext[RunbookProcess](ctx, client, template, runbook.SpaceID, runbook.ID, runbook)
 }
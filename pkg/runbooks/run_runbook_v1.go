@@ -1,6 +1,7 @@
 package runbooks
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
@@ -48,6 +49,11 @@ func (r *RunbookRunCommandV1) MarshalJSON() ([]byte, error) {
 }
 
 func RunbookRunV1(client newclient.Client, command *RunbookRunCommandV1) (*RunbookRunResponseV1, error) {
+	return RunbookRunV1WithContext(context.Background(), client, command)
+}
+
+// RunbookRunV1WithContext is like RunbookRunV1, but uses ctx to control cancellation of the HTTP requests.
+func RunbookRunV1WithContext(ctx context.Context, client newclient.Client, command *RunbookRunCommandV1) (*RunbookRunResponseV1, error) {
 	if command == nil {
 		return nil, internal.CreateInvalidParameterError("RunbookRunV1", "command")
 	}
@@ -59,5 +65,5 @@ func RunbookRunV1(client newclient.Client, command *RunbookRunCommandV1) (*Runbo
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Post[RunbookRunResponseV1](client.HttpSession(), expandedUri, command)
+	return newclient.PostWithContext[RunbookRunResponseV1](ctx, client.HttpSession(), expandedUri, command)
 }
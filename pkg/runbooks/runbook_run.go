@@ -0,0 +1,34 @@
+package runbooks
+
+import (
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// RunbookRun represents a run of a runbook snapshot in an environment, and
+// optionally for a tenant.
+type RunbookRun struct {
+	Comments               string            `json:"Comments,omitempty"`
+	Created                *time.Time        `json:"Created,omitempty"`
+	EnvironmentID          string            `json:"EnvironmentId,omitempty"`
+	ExcludedMachineIDs     []string          `json:"ExcludedMachineIds"`
+	FailureEncountered     bool              `json:"FailureEncountered"`
+	ForcePackageDownload   bool              `json:"ForcePackageDownload"`
+	FormValues             map[string]string `json:"FormValues,omitempty"`
+	FrozenRunbookProcessID string            `json:"FrozenRunbookProcessId,omitempty"`
+	Name                   string            `json:"Name,omitempty"`
+	ProjectID              string            `json:"ProjectId,omitempty"`
+	QueueTime              *time.Time        `json:"QueueTime,omitempty"`
+	QueueTimeExpiry        *time.Time        `json:"QueueTimeExpiry,omitempty"`
+	RunbookID              string            `json:"RunbookId,omitempty"`
+	RunbookSnapshotID      string            `json:"RunbookSnapshotId,omitempty"`
+	SkipActions            []string          `json:"SkipActions"`
+	SpaceID                string            `json:"SpaceId,omitempty"`
+	SpecificMachineIDs     []string          `json:"SpecificMachineIds"`
+	TaskID                 string            `json:"TaskId,omitempty"`
+	TenantID               string            `json:"TenantId,omitempty"`
+	UseGuidedFailure       bool              `json:"UseGuidedFailure"`
+
+	resources.Resource
+}
@@ -1,8 +1,16 @@
 package runbooks
 
 import (
+	"context"
+	"iter"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
 	"github.com/dghubble/sling"
 )
 
@@ -17,3 +25,121 @@ func NewRunbookRunService(sling *sling.Sling, uriTemplate string) *RunbookRunSer
 		},
 	}
 }
+
+// Get returns a collection of runbook runs based on the criteria defined by
+// its input query parameter. If an error occurs, an empty collection is
+// returned along with the associated error.
+//
+// Deprecated: use runbooks.GetRunbookRuns
+func (s *RunbookRunService) Get(runbookRunsQuery RunbookRunsQuery) (*resources.Resources[*RunbookRun], error) {
+	path, err := s.GetURITemplate().Expand(runbookRunsQuery)
+	if err != nil {
+		return &resources.Resources[*RunbookRun]{}, err
+	}
+
+	response, err := api.ApiGet(s.GetClient(), new(resources.Resources[*RunbookRun]), path)
+	if err != nil {
+		return &resources.Resources[*RunbookRun]{}, err
+	}
+
+	return response.(*resources.Resources[*RunbookRun]), nil
+}
+
+// GetByID returns the runbook run that matches the input ID. If one cannot be
+// found, it returns nil and an error.
+//
+// Deprecated: use runbooks.GetRunbookRunByID
+func (s *RunbookRunService) GetByID(id string) (*RunbookRun, error) {
+	if internal.IsEmpty(id) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
+	}
+
+	path, err := services.GetByIDPath(s, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new(RunbookRun), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*RunbookRun), nil
+}
+
+// Cancel requests cancellation of the task of a runbook run. The server
+// cancels tasks asynchronously, so the returned task will usually be in the
+// Cancelling state.
+//
+// Deprecated: use runbooks.CancelRunbookRun
+func (s *RunbookRunService) Cancel(runbookRun *RunbookRun) (*tasks.Task, error) {
+	if runbookRun == nil {
+		return nil, internal.CreateInvalidParameterError("Cancel", constants.ParameterRunbookRun)
+	}
+
+	path := runbookRun.Links[constants.LinkTask]
+	if internal.IsEmpty(path) {
+		return nil, internal.CreateInvalidParameterError("Cancel", constants.ParameterRunbookRun)
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), nil, new(tasks.Task), path+"/cancel")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*tasks.Task), nil
+}
+
+// --- new ---
+
+const runbookRunsTemplate = "/api/{spaceId}/runbookRuns{/id}{?skip,take,ids,projects,environments,tenants,runbooks,taskState,partialName}"
+
+// GetRunbookRuns returns a collection of runbook runs based on the criteria
+// defined by its input query parameter.
+func GetRunbookRuns(client newclient.Client, spaceID string, query RunbookRunsQuery) (*resources.Resources[*RunbookRun], error) {
+	return GetRunbookRunsWithContext(context.Background(), client, spaceID, query)
+}
+
+// GetRunbookRunsWithContext is like GetRunbookRuns, but uses ctx to control cancellation of the HTTP requests.
+func GetRunbookRunsWithContext(ctx context.Context, client newclient.Client, spaceID string, query RunbookRunsQuery) (*resources.Resources[*RunbookRun], error) {
+	return newclient.GetByQueryWithContext[RunbookRun](ctx, client, runbookRunsTemplate, spaceID, query)
+}
+
+// IterateRunbookRuns returns an iterator over the runbook runs matching the
+// criteria defined by its input query parameter. Pages are requested lazily
+// as the iteration proceeds; the query's Take controls the page size.
+func IterateRunbookRuns(client newclient.Client, spaceID string, query RunbookRunsQuery) iter.Seq2[*RunbookRun, error] {
+	return IterateRunbookRunsWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateRunbookRunsWithContext is like IterateRunbookRuns, but uses ctx to control cancellation of the HTTP requests.
+func IterateRunbookRunsWithContext(ctx context.Context, client newclient.Client, spaceID string, query RunbookRunsQuery) iter.Seq2[*RunbookRun, error] {
+	return newclient.IterateByQueryWithContext[RunbookRun](ctx, client, runbookRunsTemplate, spaceID, query)
+}
+
+// GetRunbookRunByID returns the runbook run that matches the input ID. If one
+// cannot be found, it returns nil and an error.
+func GetRunbookRunByID(client newclient.Client, spaceID string, ID string) (*RunbookRun, error) {
+	return GetRunbookRunByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetRunbookRunByIDWithContext is like GetRunbookRunByID, but uses ctx to control cancellation of the HTTP requests.
+func GetRunbookRunByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*RunbookRun, error) {
+	return newclient.GetByIDWithContext[RunbookRun](ctx, client, runbookRunsTemplate, spaceID, ID)
+}
+
+// CancelRunbookRun requests cancellation of the task of the runbook run that
+// matches the input ID. The server cancels tasks asynchronously, so the
+// returned task will usually be in the Cancelling state.
+func CancelRunbookRun(client newclient.Client, spaceID string, ID string) (*tasks.Task, error) {
+	return CancelRunbookRunWithContext(context.Background(), client, spaceID, ID)
+}
+
+// CancelRunbookRunWithContext is like CancelRunbookRun, but uses ctx to control cancellation of the HTTP requests.
+func CancelRunbookRunWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*tasks.Task, error) {
+	runbookRun, err := GetRunbookRunByIDWithContext(ctx, client, spaceID, ID)
+	if err != nil {
+		return nil, err
+	}
+	return tasks.CancelWithContext(ctx, client, spaceID, runbookRun.TaskID)
+}
@@ -0,0 +1,142 @@
+package runbooks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+// RunOutcomeState summarizes how a runbook run ended. It is the state of the
+// run's task, as reported by tasks.FollowTask.
+type RunOutcomeState = tasks.OutcomeState
+
+const (
+	RunOutcomeSucceeded   = tasks.OutcomeSucceeded
+	RunOutcomeFailed      = tasks.OutcomeFailed
+	RunOutcomeInterrupted = tasks.OutcomeInterrupted
+	RunOutcomeIncomplete  = tasks.OutcomeIncomplete
+)
+
+// RunOptions controls how RunAndWait follows the runs it creates.
+type RunOptions struct {
+	// Wait controls how each run's task is polled. Its Timeout applies to
+	// each run separately, and its OnPoll is called from several
+	// goroutines, so must be safe for concurrent use.
+	Wait *tasks.WaitOptions
+}
+
+// RunOutcome is the result of one runbook run created by RunAndWait.
+type RunOutcome struct {
+	RunbookRunID  string
+	ServerTaskID  string
+	EnvironmentID string
+
+	// TenantID is empty for untenanted runs.
+	TenantID string
+	State    RunOutcomeState
+
+	// Task is the last state of the run's task that was seen.
+	Task *tasks.Task
+
+	// Err is set when the run did not succeed, or could not be followed to
+	// its end.
+	Err error
+}
+
+// RunResult is the result of the runs created by RunAndWait, in the order
+// the server created them.
+type RunResult struct {
+	Outcomes []*RunOutcome
+}
+
+// Succeeded reports whether every run succeeded.
+func (r *RunResult) Succeeded() bool {
+	for _, outcome := range r.Outcomes {
+		if outcome.State != RunOutcomeSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// ByEnvironment groups the outcomes by the ID of the environment run in.
+func (r *RunResult) ByEnvironment() map[string][]*RunOutcome {
+	return r.groupBy(func(outcome *RunOutcome) string { return outcome.EnvironmentID })
+}
+
+// ByTenant groups the outcomes by the ID of the tenant run for. The outcomes
+// of untenanted runs are grouped under the empty string.
+func (r *RunResult) ByTenant() map[string][]*RunOutcome {
+	return r.groupBy(func(outcome *RunOutcome) string { return outcome.TenantID })
+}
+
+func (r *RunResult) groupBy(key func(*RunOutcome) string) map[string][]*RunOutcome {
+	groups := map[string][]*RunOutcome{}
+	for _, outcome := range r.Outcomes {
+		groups[key(outcome)] = append(groups[key(outcome)], outcome)
+	}
+	return groups
+}
+
+// RunAndWait runs a runbook, then waits for all of the runs it creates (one
+// for each environment and tenant) to complete and reports the outcome of
+// each. The runs are followed concurrently. An error is returned only if the
+// runs could not be created or ctx is done; the success or failure of each
+// run is reported in its outcome.
+func RunAndWait(client newclient.Client, command *RunbookRunCommandV1, options *RunOptions) (*RunResult, error) {
+	return RunAndWaitWithContext(context.Background(), client, command, options)
+}
+
+// RunAndWaitWithContext is like RunAndWait, but uses ctx to control cancellation of the HTTP requests.
+func RunAndWaitWithContext(ctx context.Context, client newclient.Client, command *RunbookRunCommandV1, options *RunOptions) (*RunResult, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	response, err := RunbookRunV1WithContext(ctx, client, command)
+	if err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = &RunOptions{}
+	}
+
+	result := &RunResult{Outcomes: make([]*RunOutcome, len(response.RunbookRunServerTasks))}
+	var wg sync.WaitGroup
+	for i, runbookRunServerTask := range response.RunbookRunServerTasks {
+		outcome := &RunOutcome{
+			RunbookRunID: runbookRunServerTask.RunbookRunID,
+			ServerTaskID: runbookRunServerTask.ServerTaskID,
+			State:        RunOutcomeIncomplete,
+		}
+		result.Outcomes[i] = outcome
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			followRunbookRun(ctx, client, command.SpaceID, outcome, options)
+		}()
+	}
+	wg.Wait()
+
+	return result, ctx.Err()
+}
+
+// followRunbookRun waits for a run's task to complete and records how it
+// ended in outcome.
+func followRunbookRun(ctx context.Context, client newclient.Client, spaceID string, outcome *RunOutcome, options *RunOptions) {
+	runbookRun, err := GetRunbookRunByIDWithContext(ctx, client, spaceID, outcome.RunbookRunID)
+	if err != nil {
+		outcome.Err = err
+		return
+	}
+	outcome.EnvironmentID = runbookRun.EnvironmentID
+	outcome.TenantID = runbookRun.TenantID
+
+	followed := tasks.FollowTaskWithContext(ctx, client, spaceID, outcome.ServerTaskID, options.Wait)
+	outcome.State = followed.State
+	outcome.Task = followed.Task
+	outcome.Err = followed.Err
+}
@@ -0,0 +1,106 @@
+package runbooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishSnapshot(t *testing.T) {
+	var body map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/runbookSnapshots", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "true", r.URL.Query().Get("publish"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = w.Write([]byte(`{"Id": "RunbookSnapshots-1", "Name": "Snapshot 1", "ProjectId": "Projects-1", "RunbookId": "Runbooks-1"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	snapshot, err := PublishSnapshot(client, NewRunbookSnapshot("Snapshot 1", "Projects-1", "Runbooks-1"))
+	require.NoError(t, err)
+	require.Equal(t, "RunbookSnapshots-1", snapshot.GetID())
+	require.Equal(t, "Runbooks-1", body["RunbookId"])
+
+	_, err = PublishSnapshot(client, &RunbookSnapshot{})
+	require.Error(t, err)
+}
+
+func TestCancelRunbookRun(t *testing.T) {
+	var canceled bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/Spaces-1/runbookRuns", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Runbooks-1", r.URL.Query().Get("runbooks"))
+		require.Equal(t, "Executing", r.URL.Query().Get("taskState"))
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "RunbookRuns-1", "TaskId": "ServerTasks-1"}], "Links": {}}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/runbookRuns/RunbookRuns-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "RunbookRuns-1", "TaskId": "ServerTasks-1"}`))
+	})
+	mux.HandleFunc("POST /api/Spaces-1/tasks/ServerTasks-1/cancel", func(w http.ResponseWriter, r *http.Request) {
+		canceled = true
+		_, _ = w.Write([]byte(`{"Id": "ServerTasks-1", "State": "Cancelling"}`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	runs, err := GetRunbookRuns(client, "", RunbookRunsQuery{Runbooks: []string{"Runbooks-1"}, TaskState: "Executing"})
+	require.NoError(t, err)
+	require.Len(t, runs.Items, 1)
+
+	task, err := CancelRunbookRun(client, "", runs.Items[0].GetID())
+	require.NoError(t, err)
+	require.True(t, canceled)
+	require.Equal(t, "Cancelling", task.State)
+}
+
+func TestRunAndWait(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/Spaces-1/runbook-runs/create/v1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"RunbookRunServerTasks": [
+			{"RunbookRunId": "RunbookRuns-1", "ServerTaskId": "ServerTasks-1"},
+			{"RunbookRunId": "RunbookRuns-2", "ServerTaskId": "ServerTasks-2"},
+			{"RunbookRunId": "RunbookRuns-3", "ServerTaskId": "ServerTasks-3"}
+		]}`))
+	})
+	mux.HandleFunc("GET /api/Spaces-1/runbookRuns/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tenantID := map[string]string{"RunbookRuns-1": "Tenants-1", "RunbookRuns-2": "Tenants-2", "RunbookRuns-3": "Tenants-3"}[r.PathValue("id")]
+		_, _ = fmt.Fprintf(w, `{"Id": %q, "EnvironmentId": "Environments-1", "TenantId": %q}`, r.PathValue("id"), tenantID)
+	})
+	mux.HandleFunc("GET /api/Spaces-1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("ids") {
+		case "ServerTasks-1":
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-1", "State": "Success"}]}`))
+		case "ServerTasks-2":
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-2", "State": "Failed", "ErrorMessage": "the script failed"}]}`))
+		case "ServerTasks-3":
+			_, _ = w.Write([]byte(`{"Items": [{"Id": "ServerTasks-3", "State": "Executing", "HasPendingInterruptions": true}]}`))
+		}
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	command := NewRunbookRunCommandV1("Spaces-1", "Projects-1")
+	command.RunbookName = "Restart"
+	command.EnvironmentNames = []string{"Environments-1"}
+	command.Tenants = []string{"Tenants-1", "Tenants-2", "Tenants-3"}
+	result, err := RunAndWaitWithContext(context.Background(), client, command, &RunOptions{
+		Wait: &tasks.WaitOptions{PollInterval: time.Millisecond, Timeout: 50 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.False(t, result.Succeeded())
+	require.Len(t, result.Outcomes, 3)
+
+	byTenant := result.ByTenant()
+	require.Equal(t, RunOutcomeSucceeded, byTenant["Tenants-1"][0].State)
+	require.NoError(t, byTenant["Tenants-1"][0].Err)
+	require.Equal(t, RunOutcomeFailed, byTenant["Tenants-2"][0].State)
+	require.ErrorIs(t, byTenant["Tenants-2"][0].Err, tasks.ErrTaskFailed)
+	require.Equal(t, RunOutcomeInterrupted, byTenant["Tenants-3"][0].State)
+	require.ErrorIs(t, byTenant["Tenants-3"][0].Err, context.DeadlineExceeded)
+	require.Len(t, result.ByEnvironment()["Environments-1"], 3)
+}
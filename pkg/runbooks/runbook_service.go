@@ -1,6 +1,7 @@
 package runbooks
 
 import (
+	"context"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
@@ -121,27 +122,52 @@ const template = "/api/{spaceId}/runbooks{/id}{?skip,take,ids,partialName,clone,
 
 // Add returns the runbook that matches the input ID.
 func Add(client newclient.Client, runbook *Runbook) (*Runbook, error) {
-	return newclient.Add[Runbook](client, template, runbook.SpaceID, runbook)
+	return AddWithContext(context.Background(), client, runbook)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, runbook *Runbook) (*Runbook, error) {
+	return newclient.AddWithContext[Runbook](ctx, client, template, runbook.SpaceID, runbook)
 }
 
 // GetByID returns the runbook that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Runbook, error) {
-	return newclient.GetByID[Runbook](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Runbook, error) {
+	return newclient.GetByIDWithContext[Runbook](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a runbook based on the one provided as input.
 func Update(client newclient.Client, runbook *Runbook) (*Runbook, error) {
-	return newclient.Update[Runbook](client, template, runbook.SpaceID, runbook.ID, runbook)
+	return UpdateWithContext(context.Background(), client, runbook)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, runbook *Runbook) (*Runbook, error) {
+	return newclient.UpdateWithContext[Runbook](ctx, client, template, runbook.SpaceID, runbook.ID, runbook)
 }
 
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // List returns a list of runbooks from the server, in a standard Octopus paginated result structure.
 // If you don't specify --limit the server will use a default limit (typically 30)
 func List(client newclient.Client, spaceID string, projectID string, filter string, limit int) (*resources.Resources[*Runbook], error) {
+	return ListWithContext(context.Background(), client, spaceID, projectID, filter, limit)
+}
+
+// ListWithContext is like List, but uses ctx to control cancellation of the HTTP requests.
+func ListWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, filter string, limit int) (*resources.Resources[*Runbook], error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -160,12 +186,17 @@ func List(client newclient.Client, spaceID string, projectID string, filter stri
 		return nil, err
 	}
 
-	return newclient.Get[resources.Resources[*Runbook]](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[resources.Resources[*Runbook]](ctx, client.HttpSession(), expandedUri)
 }
 
 // GetByName searches for a single runbook with name of 'name'.
 // If no such runbook can be found, will return nil, nil
 func GetByName(client newclient.Client, spaceID string, projectID string, name string) (*Runbook, error) {
+	return GetByNameWithContext(context.Background(), client, spaceID, projectID, name)
+}
+
+// GetByNameWithContext is like GetByName, but uses ctx to control cancellation of the HTTP requests.
+func GetByNameWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, name string) (*Runbook, error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -181,7 +212,7 @@ func GetByName(client newclient.Client, spaceID string, projectID string, name s
 		return nil, err
 	}
 
-	searchResults, err := newclient.Get[resources.Resources[*Runbook]](client.HttpSession(), expandedUri)
+	searchResults, err := newclient.GetWithContext[resources.Resources[*Runbook]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +227,11 @@ func GetByName(client newclient.Client, spaceID string, projectID string, name s
 // ListSnapshots returns a list of runbook snapshots from the server, in a standard Octopus paginated result structure.
 // If you don't specify --limit the server will use a default limit (typically 30)
 func ListSnapshots(client newclient.Client, spaceID string, projectID string, runbookID string, limit int) (*resources.Resources[*RunbookSnapshot], error) {
+	return ListSnapshotsWithContext(context.Background(), client, spaceID, projectID, runbookID, limit)
+}
+
+// ListSnapshotsWithContext is like ListSnapshots, but uses ctx to control cancellation of the HTTP requests.
+func ListSnapshotsWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, runbookID string, limit int) (*resources.Resources[*RunbookSnapshot], error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -214,12 +250,17 @@ func ListSnapshots(client newclient.Client, spaceID string, projectID string, ru
 		return nil, err
 	}
 
-	return newclient.Get[resources.Resources[*RunbookSnapshot]](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[resources.Resources[*RunbookSnapshot]](ctx, client.HttpSession(), expandedUri)
 }
 
 // GetSnapshot loads a single runbook snapshot.
 // You can supply either a name "Snapshot FWKMLUX" or an ID "RunbookSnapshots-41" for snapshotIDorName
 func GetSnapshot(client newclient.Client, spaceID string, projectID string, snapshotIDorName string) (*RunbookSnapshot, error) {
+	return GetSnapshotWithContext(context.Background(), client, spaceID, projectID, snapshotIDorName)
+}
+
+// GetSnapshotWithContext is like GetSnapshot, but uses ctx to control cancellation of the HTTP requests.
+func GetSnapshotWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, snapshotIDorName string) (*RunbookSnapshot, error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -236,11 +277,16 @@ func GetSnapshot(client newclient.Client, spaceID string, projectID string, snap
 		return nil, err
 	}
 
-	return newclient.Get[RunbookSnapshot](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[RunbookSnapshot](ctx, client.HttpSession(), expandedUri)
 }
 
 // ListEnvironments returns the list of valid environments for a given runbook
 func ListEnvironments(client newclient.Client, spaceID string, projectID string, runbookID string) ([]*environments.Environment, error) {
+	return ListEnvironmentsWithContext(context.Background(), client, spaceID, projectID, runbookID)
+}
+
+// ListEnvironmentsWithContext is like ListEnvironments, but uses ctx to control cancellation of the HTTP requests.
+func ListEnvironmentsWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, runbookID string) ([]*environments.Environment, error) {
 	if spaceID == "" {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("spaceID")
 	}
@@ -257,7 +303,7 @@ func ListEnvironments(client newclient.Client, spaceID string, projectID string,
 	}
 
 	// our generic Get method must return pointers, so we need to dereference the pointer-to-slice before returning it
-	tmp, err := newclient.Get[[]*environments.Environment](client.HttpSession(), expandedUri)
+	tmp, err := newclient.GetWithContext[[]*environments.Environment](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return nil, err
 	}
@@ -267,6 +313,11 @@ func ListEnvironments(client newclient.Client, spaceID string, projectID string,
 // GetProcess fetches a runbook process. This may either be the project level process (template),
 // or a snapshot, depending on the value of ID
 func GetProcess(client newclient.Client, spaceID string, projectID string, ID string) (*RunbookProcess, error) {
+	return GetProcessWithContext(context.Background(), client, spaceID, projectID, ID)
+}
+
+// GetProcessWithContext is like GetProcess, but uses ctx to control cancellation of the HTTP requests.
+func GetProcessWithContext(ctx context.Context, client newclient.Client, spaceID string, projectID string, ID string) (*RunbookProcess, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetProcess", "client")
 	}
@@ -288,13 +339,18 @@ func GetProcess(client newclient.Client, spaceID string, projectID string, ID st
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[RunbookProcess](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[RunbookProcess](ctx, client.HttpSession(), expandedUri)
 }
 
 // GetRunbookSnapshotRunPreview gets a preview of a snapshot run for a given environment.
 // This is used by the portal to show which machines would be deployed to, and other information about the deployment,
 // before proceeding with it. The CLI uses it to build the selector for picking specific machines to deploy to
 func GetRunbookSnapshotRunPreview(client newclient.Client, spaceID string, snapshotID string, environmentID string, includeDisabledSteps bool) (*RunPreview, error) {
+	return GetRunbookSnapshotRunPreviewWithContext(context.Background(), client, spaceID, snapshotID, environmentID, includeDisabledSteps)
+}
+
+// GetRunbookSnapshotRunPreviewWithContext is like GetRunbookSnapshotRunPreview, but uses ctx to control cancellation of the HTTP requests.
+func GetRunbookSnapshotRunPreviewWithContext(ctx context.Context, client newclient.Client, spaceID string, snapshotID string, environmentID string, includeDisabledSteps bool) (*RunPreview, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetRunbookRunPreview", "client")
 	}
@@ -317,7 +373,7 @@ func GetRunbookSnapshotRunPreview(client newclient.Client, spaceID string, snaps
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[RunPreview](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[RunPreview](ctx, client.HttpSession(), expandedUri)
 }
 
 // TODO there is also a tenanted preview, request/response below.
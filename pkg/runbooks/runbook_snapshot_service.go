@@ -1,8 +1,11 @@
 package runbooks
 
 import (
+	"context"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/dghubble/sling"
@@ -43,6 +46,37 @@ func (s *RunbookSnapshotService) Add(runbookSnapshot *RunbookSnapshot) (*Runbook
 	return response.(*RunbookSnapshot), nil
 }
 
+// PublishSnapshot creates a new runbook snapshot and sets it as the published
+// snapshot of its runbook, which is the snapshot used when the runbook is run
+// without naming one.
+//
+// Deprecated: use runbooks.PublishSnapshot
+func (s *RunbookSnapshotService) PublishSnapshot(runbookSnapshot *RunbookSnapshot) (*RunbookSnapshot, error) {
+	if IsNil(runbookSnapshot) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterRunbookSnapshot)
+	}
+
+	if err := runbookSnapshot.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError(constants.OperationAdd, err)
+	}
+
+	if err := services.ValidateInternalState(s); err != nil {
+		return nil, err
+	}
+
+	path, err := s.GetURITemplate().Expand(map[string]interface{}{"publish": true})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := services.ApiAdd(s.GetClient(), runbookSnapshot, new(RunbookSnapshot), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*RunbookSnapshot), nil
+}
+
 // GetByID returns the release that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func (s *RunbookSnapshotService) GetByID(id string) (*RunbookSnapshot, error) {
@@ -62,3 +96,34 @@ func (s *RunbookSnapshotService) GetByID(id string) (*RunbookSnapshot, error) {
 
 	return resp.(*RunbookSnapshot), nil
 }
+
+// --- new ---
+
+const runbookSnapshotsTemplate = "/api/{spaceId}/runbookSnapshots{/id}{?skip,take,ids,publish}"
+
+// PublishSnapshot creates a new runbook snapshot and sets it as the published
+// snapshot of its runbook, which is the snapshot used when the runbook is run
+// without naming one.
+func PublishSnapshot(client newclient.Client, runbookSnapshot *RunbookSnapshot) (*RunbookSnapshot, error) {
+	return PublishSnapshotWithContext(context.Background(), client, runbookSnapshot)
+}
+
+// PublishSnapshotWithContext is like PublishSnapshot, but uses ctx to control cancellation of the HTTP requests.
+func PublishSnapshotWithContext(ctx context.Context, client newclient.Client, runbookSnapshot *RunbookSnapshot) (*RunbookSnapshot, error) {
+	if IsNil(runbookSnapshot) {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterRunbookSnapshot)
+	}
+	if err := runbookSnapshot.Validate(); err != nil {
+		return nil, internal.CreateValidationFailureError(constants.OperationAdd, err)
+	}
+	spaceID, err := internal.GetSpaceID(runbookSnapshot.SpaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := client.URITemplateCache().Expand(runbookSnapshotsTemplate, map[string]any{"spaceId": spaceID, "publish": true})
+	if err != nil {
+		return nil, err
+	}
+	return newclient.PostWithContext[RunbookSnapshot](ctx, client.HttpSession(), path, runbookSnapshot)
+}
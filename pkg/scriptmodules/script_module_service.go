@@ -1,20 +1,28 @@
 package scriptmodules
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"strings"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
-	"strings"
 )
 
 const contentType = "ScriptModule"
 
 // Add creates a new script module.
 func Add(client newclient.Client, scriptModule *variables.ScriptModule) (*variables.ScriptModule, error) {
+	return AddWithContext(context.Background(), client, scriptModule)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, scriptModule *variables.ScriptModule) (*variables.ScriptModule, error) {
 	if scriptModule == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterScriptModule)
 	}
@@ -29,7 +37,7 @@ func Add(client newclient.Client, scriptModule *variables.ScriptModule) (*variab
 		return nil, err
 	}
 
-	scriptModuleResponse, err := newclient.Post[variables.ScriptModule](client.HttpSession(), expandedUri, scriptModule)
+	scriptModuleResponse, err := newclient.PostWithContext[variables.ScriptModule](ctx, client.HttpSession(), expandedUri, scriptModule)
 
 	// update associated variable set; add script body and syntax
 	variablesPath, err := client.URITemplateCache().Expand(uritemplates.Variables, map[string]any{
@@ -40,7 +48,7 @@ func Add(client newclient.Client, scriptModule *variables.ScriptModule) (*variab
 		return nil, err
 	}
 
-	variablesResponse, err := newclient.Get[variables.VariableSet](client.HttpSession(), variablesPath)
+	variablesResponse, err := newclient.GetWithContext[variables.VariableSet](ctx, client.HttpSession(), variablesPath)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +62,7 @@ func Add(client newclient.Client, scriptModule *variables.ScriptModule) (*variab
 	syntaxVariable.Value = scriptModule.Syntax
 	variableSet.Variables = append(variableSet.Variables, syntaxVariable)
 
-	_, err = newclient.Put[variables.VariableSet](client.HttpSession(), variablesPath, variableSet)
+	_, err = newclient.PutWithContext[variables.VariableSet](ctx, client.HttpSession(), variablesPath, variableSet)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +77,11 @@ func Add(client newclient.Client, scriptModule *variables.ScriptModule) (*variab
 // defined by its input query parameter. If an error occurs, an empty
 // collection is returned along with the associated error.
 func Get(client newclient.Client, spaceID string, libraryVariablesQuery variables.LibraryVariablesQuery) (*resources.Resources[*variables.ScriptModule], error) {
+	return GetWithContext(context.Background(), client, spaceID, libraryVariablesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, libraryVariablesQuery variables.LibraryVariablesQuery) (*resources.Resources[*variables.ScriptModule], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -85,7 +98,7 @@ func Get(client newclient.Client, spaceID string, libraryVariablesQuery variable
 		return nil, err
 	}
 
-	resp, err := newclient.Get[resources.Resources[*variables.ScriptModule]](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[resources.Resources[*variables.ScriptModule]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*variables.ScriptModule]{}, err
 	}
@@ -93,9 +106,26 @@ func Get(client newclient.Client, spaceID string, libraryVariablesQuery variable
 	return resp, nil
 }
 
+// Iterate returns an iterator over the script modules matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query variables.LibraryVariablesQuery) iter.Seq2[*variables.ScriptModule, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query variables.LibraryVariablesQuery) iter.Seq2[*variables.ScriptModule, error] {
+	return newclient.IterateByQueryWithContext[variables.ScriptModule](ctx, client, uritemplates.LibraryVariableSets, spaceID, query)
+}
+
 // GetByID returns the script module that matches the space ID and input ID. If one
 // cannot be found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, id string) (*variables.ScriptModule, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) (*variables.ScriptModule, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -111,7 +141,7 @@ func GetByID(client newclient.Client, spaceID string, id string) (*variables.Scr
 		"contentType": contentType,
 	})
 
-	scriptModuleResponse, err := newclient.Get[variables.ScriptModule](client.HttpSession(), expandedUri)
+	scriptModuleResponse, err := newclient.GetWithContext[variables.ScriptModule](ctx, client.HttpSession(), expandedUri)
 
 	// get associated variable set
 	variablesPath, err := client.URITemplateCache().Expand(uritemplates.Variables, map[string]any{
@@ -119,7 +149,7 @@ func GetByID(client newclient.Client, spaceID string, id string) (*variables.Scr
 		"id":      scriptModuleResponse.VariableSetID,
 	})
 
-	variablesResponse, err := newclient.Get[variables.VariableSet](client.HttpSession(), variablesPath)
+	variablesResponse, err := newclient.GetWithContext[variables.VariableSet](ctx, client.HttpSession(), variablesPath)
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +170,11 @@ func GetByID(client newclient.Client, spaceID string, id string) (*variables.Scr
 
 // Update modifies a script module based on the one provided as input.
 func Update(client newclient.Client, scriptModule *variables.ScriptModule) (*variables.ScriptModule, error) {
+	return UpdateWithContext(context.Background(), client, scriptModule)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, scriptModule *variables.ScriptModule) (*variables.ScriptModule, error) {
 	if scriptModule == nil {
 		return nil, internal.CreateInvalidParameterError(constants.OperationUpdate, "scriptModule")
 	}
@@ -158,7 +193,7 @@ func Update(client newclient.Client, scriptModule *variables.ScriptModule) (*var
 	}
 
 	// update script module
-	scriptModuleResponse, err := newclient.Put[variables.ScriptModule](client.HttpSession(), expandedUri, scriptModule)
+	scriptModuleResponse, err := newclient.PutWithContext[variables.ScriptModule](ctx, client.HttpSession(), expandedUri, scriptModule)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +207,7 @@ func Update(client newclient.Client, scriptModule *variables.ScriptModule) (*var
 		return nil, err
 	}
 
-	variableSet, err := newclient.Get[variables.VariableSet](client.HttpSession(), variablesPath)
+	variableSet, err := newclient.GetWithContext[variables.VariableSet](ctx, client.HttpSession(), variablesPath)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +222,7 @@ func Update(client newclient.Client, scriptModule *variables.ScriptModule) (*var
 		}
 	}
 
-	updatedVariableSet, err := newclient.Put[variables.VariableSet](client.HttpSession(), variablesPath, variableSet)
+	updatedVariableSet, err := newclient.PutWithContext[variables.VariableSet](ctx, client.HttpSession(), variablesPath, variableSet)
 
 	for _, variable := range updatedVariableSet.Variables {
 		if strings.HasPrefix(variable.Name, "Octopus.Script.Module[") {
@@ -204,6 +239,11 @@ func Update(client newclient.Client, scriptModule *variables.ScriptModule) (*var
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -216,5 +256,5 @@ func DeleteByID(client newclient.Client, spaceID string, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
@@ -1,7 +1,6 @@
 package api
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -39,11 +38,6 @@ func ApiGet(sling *sling.Sling, inputStruct interface{}, path string) (interface
 	// 	return nil, err
 	// }
 
-	// core.APIErrorChecker doesn't give us useful handling for auth errors; do this specifically
-	if resp != nil && resp.StatusCode == http.StatusUnauthorized {
-		return nil, errors.New("unauthorized")
-	}
-
 	apiErrorCheck := core.APIErrorChecker(path, resp, http.StatusOK, err, octopusDeployError)
 	if apiErrorCheck != nil {
 		return nil, apiErrorCheck
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strings"
@@ -59,22 +60,42 @@ func NewService(name string, sling *sling.Sling, uriTemplate string) Service {
 
 func GetPagedResponse[T any](s IService, path string) ([]*T, error) {
 	resourcesToReturn := []*T{}
-	loadNextPage := true
-
-	for loadNextPage {
-		resp, err := api.ApiGet(s.GetClient(), new(resources.Resources[*T]), path)
+	for resource, err := range IteratePagedResponse[T](s, path) {
 		if err != nil {
 			return resourcesToReturn, err
 		}
-
-		responseList := resp.(*resources.Resources[*T])
-		resourcesToReturn = append(resourcesToReturn, responseList.Items...)
-		path, loadNextPage = LoadNextPage(responseList.PagedResults)
+		resourcesToReturn = append(resourcesToReturn, resource)
 	}
 
 	return resourcesToReturn, nil
 }
 
+// IteratePagedResponse returns an iterator over the resources of the paged
+// collection found at path. Unlike GetPagedResponse, pages are requested lazily
+// as the iteration proceeds, and breaking out of the iteration early stops any
+// further pages from being requested.
+func IteratePagedResponse[T any](s IService, path string) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		loadNextPage := true
+
+		for loadNextPage {
+			resp, err := api.ApiGet(s.GetClient(), new(resources.Resources[*T]), path)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			responseList := resp.(*resources.Resources[*T])
+			for _, item := range responseList.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			path, loadNextPage = LoadNextPage(responseList.PagedResults)
+		}
+	}
+}
+
 func (s *Service) GetBasePath() string {
 	return s.BasePath
 }
@@ -421,6 +442,10 @@ func doRequestReturningJson[TResponse any](httpClient *http.Client, req *http.Re
 		_ = resp.Body.Close()
 	}()
 
+	if (resp.StatusCode < 200 || resp.StatusCode > 299) && resp.ContentLength == 0 {
+		return nil, core.NewAPIError(resp, nil)
+	}
+
 	if resp.StatusCode == http.StatusNoContent || resp.ContentLength == 0 {
 		// Potential gotcha: If someone calls this with TResponse of string, int or other non-nullable primitive,
 		// then this may panic. But why are you using a non-nullable response type on a server endpoint that can return no content?
@@ -440,12 +465,10 @@ func doRequestReturningJson[TResponse any](httpClient *http.Client, req *http.Re
 		}
 		return responsePayload, nil
 	} else {
+		// an undecodable body still produces an APIError carrying the status code
 		errorPayload := new(core.APIError)
-		err = bodyDecoder.Decode(errorPayload)
-		if err != nil {
-			return nil, err
-		}
-		return nil, errorPayload
+		_ = bodyDecoder.Decode(errorPayload)
+		return nil, core.NewAPIError(resp, errorPayload)
 	}
 	// don't use core.APIErrorChecker, it's overly helpful and gets in the way of error handling.
 }
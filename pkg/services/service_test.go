@@ -0,0 +1,84 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/dghubble/sling"
+	"github.com/stretchr/testify/require"
+)
+
+type testThing struct {
+	ID string `json:"Id"`
+}
+
+// newPagedService returns a service whose collection holds count things,
+// served in pages of the size requested by take, and a pointer to the
+// number of pages which have been requested.
+func newPagedService(t *testing.T, count int) (*Service, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		take, err := strconv.Atoi(r.URL.Query().Get("take"))
+		require.NoError(t, err)
+
+		items := ""
+		for i := skip; i < min(skip+take, count); i++ {
+			if i > skip {
+				items += ","
+			}
+			items += fmt.Sprintf(`{"Id": "Things-%d"}`, i+1)
+		}
+		next := ""
+		if skip+take < count {
+			next = fmt.Sprintf("/api/things?skip=%d&take=%d", skip+take, take)
+		}
+		_, _ = fmt.Fprintf(w, `{"Items": [%s], "ItemsPerPage": %d, "Links": {"Page.Next": %q}}`, items, take, next)
+	}))
+	t.Cleanup(server.Close)
+
+	service := NewService(constants.ServiceProjectService, sling.New().Base(server.URL).Client(server.Client()), "/api/things{?skip,take}")
+	return &service, &requests
+}
+
+func TestGetPagedResponse(t *testing.T) {
+	service, requests := newPagedService(t, 5)
+
+	things, err := GetPagedResponse[testThing](service, "/api/things?take=2")
+	require.NoError(t, err)
+	require.Len(t, things, 5)
+	require.Equal(t, "Things-5", things[4].ID)
+	require.Equal(t, 3, *requests)
+}
+
+func TestIteratePagedResponseStopsRequestingPagesWhenBroken(t *testing.T) {
+	service, requests := newPagedService(t, 5)
+
+	ids := []string{}
+	for thing, err := range IteratePagedResponse[testThing](service, "/api/things?take=2") {
+		require.NoError(t, err)
+		ids = append(ids, thing.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+	require.Equal(t, []string{"Things-1", "Things-2", "Things-3"}, ids)
+	require.Equal(t, 2, *requests)
+}
+
+func TestIteratePagedResponseUsesPageSizeOfPath(t *testing.T) {
+	service, requests := newPagedService(t, 5)
+
+	count := 0
+	for _, err := range IteratePagedResponse[testThing](service, "/api/things?take=5") {
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 5, count)
+	require.Equal(t, 1, *requests)
+}
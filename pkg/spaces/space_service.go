@@ -1,6 +1,8 @@
 package spaces
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -171,12 +173,17 @@ const (
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func Get(client newclient.Client, spacesQuery SpacesQuery) (*resources.Resources[*Space], error) {
+	return GetWithContext(context.Background(), client, spacesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spacesQuery SpacesQuery) (*resources.Resources[*Space], error) {
 	path, err := client.URITemplateCache().Expand(spacesTemplate, spacesQuery)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := newclient.Get[resources.Resources[*Space]](client.HttpSession(), path)
+	res, err := newclient.GetWithContext[resources.Resources[*Space]](ctx, client.HttpSession(), path)
 	if err != nil {
 		return &resources.Resources[*Space]{}, err
 	}
@@ -184,36 +191,52 @@ func Get(client newclient.Client, spacesQuery SpacesQuery) (*resources.Resources
 	return res, nil
 }
 
+// Iterate returns an iterator over the spaces matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spacesQuery SpacesQuery) iter.Seq2[*Space, error] {
+	return IterateWithContext(context.Background(), client, spacesQuery)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spacesQuery SpacesQuery) iter.Seq2[*Space, error] {
+	return func(yield func(*Space, error) bool) {
+		path, err := client.URITemplateCache().Expand(spacesTemplate, spacesQuery)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		for space, err := range newclient.IterateByPathWithContext[Space](ctx, client, path) {
+			if !yield(space, err) {
+				return
+			}
+		}
+	}
+}
+
 // GetAll returns all spaces. If none can be found or an error occurs, it
 // returns an empty collection.
 func GetAll(client newclient.Client) ([]*Space, error) {
+	return GetAllWithContext(context.Background(), client)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client) ([]*Space, error) {
 	path, err := client.URITemplateCache().Expand(spacesTemplate, map[string]any{})
 	if err != nil {
 		return nil, err
 	}
-	spaces := make([]*Space, 0)
-	res, err := newclient.Get[resources.Resources[*Space]](client.HttpSession(), path)
-	if err != nil {
-		return nil, err
-	}
-	spaces = append(spaces, res.Items...)
-	for res.Links.PageNext != "" {
-		nextPagePath, err := client.URITemplateCache().Expand(res.Links.PageNext, map[string]any{})
-		if err != nil {
-			return nil, err
-		}
-		res, err = newclient.Get[resources.Resources[*Space]](client.HttpSession(), nextPagePath)
-		if err != nil {
-			return nil, err
-		}
-		spaces = append(spaces, res.Items...)
-	}
-	return spaces, err
+	return newclient.Collect(newclient.IterateByPathWithContext[Space](ctx, client, path))
 }
 
 // GetByID returns the space that matches the input ID. If one cannot be found,
 // it returns nil and an error.
 func GetByID(client newclient.Client, id string) (*Space, error) {
+	return GetByIDWithContext(context.Background(), client, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, id string) (*Space, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
 	}
@@ -225,7 +248,7 @@ func GetByID(client newclient.Client, id string) (*Space, error) {
 		return nil, err
 	}
 
-	res, err := newclient.Get[Space](client.HttpSession(), path)
+	res, err := newclient.GetWithContext[Space](ctx, client.HttpSession(), path)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +258,11 @@ func GetByID(client newclient.Client, id string) (*Space, error) {
 
 // Update modifies a space based on the one provided as input.
 func Update(client newclient.Client, space *Space) (*Space, error) {
+	return UpdateWithContext(context.Background(), client, space)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, space *Space) (*Space, error) {
 	if space == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("space")
 	}
@@ -246,7 +274,7 @@ func Update(client newclient.Client, space *Space) (*Space, error) {
 		return nil, err
 	}
 
-	res, err := newclient.Put[Space](client.HttpSession(), path, space)
+	res, err := newclient.PutWithContext[Space](ctx, client.HttpSession(), path, space)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +284,13 @@ func Update(client newclient.Client, space *Space) (*Space, error) {
 
 // GetDefaultSpace tries to find default space. Returns nil if a default space can not be found.
 func GetDefaultSpace(client newclient.Client) (*Space, error) {
+	return GetDefaultSpaceWithContext(context.Background(), client)
+}
+
+// GetDefaultSpaceWithContext is like GetDefaultSpace, but uses ctx to control cancellation of the HTTP requests.
+func GetDefaultSpaceWithContext(ctx context.Context, client newclient.Client) (*Space, error) {
 	// TODO: this should change to return a custom error (can't find default space)
-	spaces, err := GetAll(client)
+	spaces, err := GetAllWithContext(ctx, client)
 	if err != nil {
 		return nil, err
 	}
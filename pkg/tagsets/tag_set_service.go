@@ -1,6 +1,8 @@
 package tagsets
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -156,31 +158,73 @@ const template = "/api/{spaceId}/tagsets{/id}{?skip,take,ids,partialName}"
 
 // Add creates a new tag set.
 func Add(client newclient.Client, tagSet *TagSet) (*TagSet, error) {
-	return newclient.Add[TagSet](client, template, tagSet.SpaceID, tagSet)
+	return AddWithContext(context.Background(), client, tagSet)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, tagSet *TagSet) (*TagSet, error) {
+	return newclient.AddWithContext[TagSet](ctx, client, template, tagSet.SpaceID, tagSet)
 }
 
 // Get returns a collection of tag sets based on the criteria defined by its
 // input query parameter.
 func Get(client newclient.Client, spaceID string, tagSetsQuery TagSetsQuery) (*resources.Resources[*TagSet], error) {
-	return newclient.GetByQuery[TagSet](client, template, spaceID, tagSetsQuery)
+	return GetWithContext(context.Background(), client, spaceID, tagSetsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, tagSetsQuery TagSetsQuery) (*resources.Resources[*TagSet], error) {
+	return newclient.GetByQueryWithContext[TagSet](ctx, client, template, spaceID, tagSetsQuery)
+}
+
+// Iterate returns an iterator over the tag sets matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query TagSetsQuery) iter.Seq2[*TagSet, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query TagSetsQuery) iter.Seq2[*TagSet, error] {
+	return newclient.IterateByQueryWithContext[TagSet](ctx, client, template, spaceID, query)
 }
 
 // GetByID returns the tag set that matches the input ID.
 func GetByID(client newclient.Client, spaceID string, ID string) (*TagSet, error) {
-	return newclient.GetByID[TagSet](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*TagSet, error) {
+	return newclient.GetByIDWithContext[TagSet](ctx, client, template, spaceID, ID)
 }
 
 // Update modifies a tag set based on the one provided as input.
 func Update(client newclient.Client, tagSet *TagSet) (*TagSet, error) {
-	return newclient.Update[TagSet](client, template, tagSet.SpaceID, tagSet.ID, tagSet)
+	return UpdateWithContext(context.Background(), client, tagSet)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, tagSet *TagSet) (*TagSet, error) {
+	return newclient.UpdateWithContext[TagSet](ctx, client, template, tagSet.SpaceID, tagSet.ID, tagSet)
 }
 
 // DeleteByID deletes the tag set that matches the provided ID.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetAll returns all tag sets. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*TagSet, error) {
-	return newclient.GetAll[TagSet](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*TagSet, error) {
+	return newclient.GetAllWithContext[TagSet](ctx, client, template, spaceID)
 }
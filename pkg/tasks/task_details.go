@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"time"
+)
+
+// TaskDetails is the detailed view of a server task, including its activity log tree.
+type TaskDetails struct {
+	ActivityLogs    []*ActivityElement `json:"ActivityLogs,omitempty"`
+	PhysicalLogSize int64              `json:"PhysicalLogSize,omitempty"`
+	Progress        *TaskProgress      `json:"Progress,omitempty"`
+	Task            *Task              `json:"Task,omitempty"`
+	Links           map[string]string  `json:"Links,omitempty"`
+}
+
+// TaskProgress describes how far through its execution a task is.
+type TaskProgress struct {
+	EstimatedTimeRemaining string `json:"EstimatedTimeRemaining,omitempty"`
+	ProgressPercentage     int    `json:"ProgressPercentage"`
+}
+
+// ActivityElement is a node in the activity log tree of a task. The root elements represent the task itself,
+// and their children represent steps, actions and targets.
+type ActivityElement struct {
+	Children           []*ActivityElement    `json:"Children,omitempty"`
+	Ended              *time.Time            `json:"Ended,omitempty"`
+	ID                 string                `json:"Id,omitempty"`
+	LogElements        []*ActivityLogElement `json:"LogElements,omitempty"`
+	Name               string                `json:"Name,omitempty"`
+	ProgressMessage    string                `json:"ProgressMessage,omitempty"`
+	ProgressPercentage int                   `json:"ProgressPercentage"`
+	ShowAtSummaryLevel bool                  `json:"ShowAtSummaryLevel"`
+	Started            *time.Time            `json:"Started,omitempty"`
+	Status             string                `json:"Status,omitempty"`
+}
+
+// ActivityLogElement is a single log line within an ActivityElement.
+type ActivityLogElement struct {
+	Category    string    `json:"Category,omitempty"`
+	Detail      string    `json:"Detail,omitempty"`
+	MessageText string    `json:"MessageText,omitempty"`
+	OccurredAt  time.Time `json:"OccurredAt,omitempty"`
+}
@@ -0,0 +1,10 @@
+package tasks
+
+// TaskDetailsQuery controls how much of the activity log is returned with the
+// details of a task.
+type TaskDetailsQuery struct {
+	// Tail limits each activity to its last N log elements. Zero returns all of them.
+	Tail int `uri:"tail,omitempty" url:"tail,omitempty"`
+	// Verbose includes log elements in the Verbose category.
+	Verbose bool `uri:"verbose,omitempty" url:"verbose,omitempty"`
+}
@@ -0,0 +1,174 @@
+package tasks
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// LogLine is a single log element together with the activity that produced it.
+type LogLine struct {
+	ActivityID   string
+	ActivityName string
+
+	*ActivityLogElement
+}
+
+// LogLines flattens the activity log tree into a list of log lines, in
+// depth-first order.
+func (d *TaskDetails) LogLines() []*LogLine {
+	lines := []*LogLine{}
+	walkActivities(d.ActivityLogs, func(activity *ActivityElement) {
+		for _, element := range activity.LogElements {
+			lines = append(lines, newLogLine(activity, element))
+		}
+	})
+	return lines
+}
+
+// LogTailOptions controls how a LogTail polls the server.
+type LogTailOptions struct {
+	// PollInterval is the wait between polls for new log lines. Defaults to 2s.
+	PollInterval time.Duration
+	// Verbose includes log elements in the Verbose category.
+	Verbose bool
+}
+
+// LogTail incrementally reads the activity log of a running task, yielding
+// each log line exactly once. Use it like a bufio.Scanner:
+//
+//	tail := tasks.NewLogTail(client, spaceID, taskID, nil)
+//	for tail.Next(ctx) {
+//		fmt.Println(tail.Line().MessageText)
+//	}
+//	if err := tail.Err(); err != nil {
+//		...
+//	}
+//
+// Next returns false once the task has completed and every line has been
+// yielded, or when an error occurs.
+type LogTail struct {
+	client  newclient.Client
+	spaceID string
+	taskID  string
+	options LogTailOptions
+
+	seen     map[string]int
+	pending  []*LogLine
+	line     *LogLine
+	task     *Task
+	polled   bool
+	finished bool
+	err      error
+}
+
+// NewLogTail creates a LogTail for the task that matches the input ID.
+func NewLogTail(client newclient.Client, spaceID string, taskID string, options *LogTailOptions) *LogTail {
+	tail := &LogTail{
+		client:  client,
+		spaceID: spaceID,
+		taskID:  taskID,
+		seen:    map[string]int{},
+	}
+	if options != nil {
+		tail.options = *options
+	}
+	if tail.options.PollInterval <= 0 {
+		tail.options.PollInterval = defaultPollInterval
+	}
+	return tail
+}
+
+// Next advances to the next log line, polling the server and waiting for new
+// output as required. It returns false when the task has completed and all of
+// its log lines have been read, when ctx is done, or when an error occurs.
+func (t *LogTail) Next(ctx context.Context) bool {
+	for len(t.pending) == 0 {
+		if t.finished || t.err != nil {
+			t.line = nil
+			return false
+		}
+		if t.polled {
+			timer := time.NewTimer(t.options.PollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				t.err = ctx.Err()
+				continue
+			case <-timer.C:
+			}
+		}
+		t.poll(ctx)
+	}
+
+	t.line = t.pending[0]
+	t.pending = t.pending[1:]
+	return true
+}
+
+// Line returns the log line most recently read by Next.
+func (t *LogTail) Line() *LogLine {
+	return t.line
+}
+
+// Task returns the state of the task as of the most recent poll.
+func (t *LogTail) Task() *Task {
+	return t.task
+}
+
+// Err returns the first error encountered while tailing the log, if any.
+func (t *LogTail) Err() error {
+	return t.err
+}
+
+func (t *LogTail) poll(ctx context.Context) {
+	t.polled = true
+
+	details, err := GetDetailsByQueryWithContext(ctx, t.client, t.spaceID, t.taskID, TaskDetailsQuery{Verbose: t.options.Verbose})
+	if err != nil {
+		t.err = err
+		return
+	}
+
+	var lines []*LogLine
+	walkActivities(details.ActivityLogs, func(activity *ActivityElement) {
+		start := t.seen[activity.ID]
+		if start > len(activity.LogElements) {
+			// the log was truncated server-side; don't replay what we've already yielded
+			start = len(activity.LogElements)
+		}
+		for _, element := range activity.LogElements[start:] {
+			lines = append(lines, newLogLine(activity, element))
+		}
+		t.seen[activity.ID] = len(activity.LogElements)
+	})
+	// activities run in parallel, so interleave their new output chronologically
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].OccurredAt.Before(lines[j].OccurredAt)
+	})
+	t.pending = append(t.pending, lines...)
+
+	t.task = details.Task
+	if t.task != nil && TaskState(t.task.State).IsCompleted() {
+		// the server writes the final log lines before marking the task complete,
+		// so this poll has seen all of them
+		t.finished = true
+	}
+}
+
+func newLogLine(activity *ActivityElement, element *ActivityLogElement) *LogLine {
+	return &LogLine{
+		ActivityID:         activity.ID,
+		ActivityName:       activity.Name,
+		ActivityLogElement: element,
+	}
+}
+
+func walkActivities(activities []*ActivityElement, visit func(activity *ActivityElement)) {
+	for _, activity := range activities {
+		visit(activity)
+		walkActivities(activity.Children, visit)
+	}
+}
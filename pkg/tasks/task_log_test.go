@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestActivity(id string, messages ...string) *ActivityElement {
+	activity := &ActivityElement{ID: id, Name: id}
+	for i, message := range messages {
+		activity.LogElements = append(activity.LogElements, &ActivityLogElement{
+			Category:    "Info",
+			MessageText: message,
+			OccurredAt:  time.Date(2023, 1, 1, 0, 0, i, 0, time.UTC),
+		})
+	}
+	return activity
+}
+
+func TestLogTailYieldsEachLineOnce(t *testing.T) {
+	var polls int32
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/tasks/ServerTasks-1/details", r.URL.Path)
+
+		details := &TaskDetails{}
+		switch atomic.AddInt32(&polls, 1) {
+		case 1:
+			root := newTestActivity("ServerTasks-1", "Starting")
+			details.Task = newTestTask("ServerTasks-1", TaskStateExecuting)
+			details.ActivityLogs = []*ActivityElement{root}
+		case 2:
+			root := newTestActivity("ServerTasks-1", "Starting")
+			root.Children = []*ActivityElement{newTestActivity("Step-1", "Deploying", "Deployed")}
+			details.Task = newTestTask("ServerTasks-1", TaskStateExecuting)
+			details.ActivityLogs = []*ActivityElement{root}
+		default:
+			root := newTestActivity("ServerTasks-1", "Starting", "Done")
+			root.Children = []*ActivityElement{newTestActivity("Step-1", "Deploying", "Deployed")}
+			details.Task = newTestTask("ServerTasks-1", TaskStateSuccess)
+			details.ActivityLogs = []*ActivityElement{root}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(details))
+	}))
+
+	tail := NewLogTail(client, "Spaces-1", "ServerTasks-1", &LogTailOptions{PollInterval: time.Millisecond})
+
+	var messages []string
+	for tail.Next(context.Background()) {
+		messages = append(messages, tail.Line().MessageText)
+	}
+	require.NoError(t, tail.Err())
+	require.Equal(t, []string{"Starting", "Deploying", "Deployed", "Done"}, messages)
+	require.Equal(t, string(TaskStateSuccess), tail.Task().State)
+	require.Equal(t, int32(3), polls)
+}
+
+func TestLogTailStopsOnError(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"ErrorMessage":"not found"}`))
+	}))
+
+	tail := NewLogTail(client, "Spaces-1", "ServerTasks-1", nil)
+	require.False(t, tail.Next(context.Background()))
+	require.Error(t, tail.Err())
+}
+
+func TestGetRawLog(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/tasks/ServerTasks-1/raw", r.URL.Path)
+		_, _ = w.Write([]byte("line one\nline two\n"))
+	}))
+
+	reader, err := GetRawLog(client, "Spaces-1", "ServerTasks-1")
+	require.NoError(t, err)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "line one\nline two\n", string(content))
+}
+
+func TestTaskDetailsLogLines(t *testing.T) {
+	root := newTestActivity("ServerTasks-1", "Starting")
+	root.Children = []*ActivityElement{newTestActivity("Step-1", "Deploying")}
+	details := &TaskDetails{ActivityLogs: []*ActivityElement{root}}
+
+	lines := details.LogLines()
+	require.Len(t, lines, 2)
+	require.Equal(t, "ServerTasks-1", lines[0].ActivityID)
+	require.Equal(t, "Step-1", lines[1].ActivityID)
+	require.Equal(t, "Deploying", lines[1].MessageText)
+}
@@ -0,0 +1,95 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// OutcomeState summarizes how a task followed by FollowTask ended.
+type OutcomeState string
+
+const (
+	OutcomeSucceeded = OutcomeState("Succeeded")
+
+	// OutcomeFailed is the state of tasks which failed, were canceled or
+	// timed out on the server.
+	OutcomeFailed = OutcomeState("Failed")
+
+	// OutcomeInterrupted is the state of tasks which were waiting for a
+	// person to respond to a manual intervention or guided failure prompt
+	// when the wait ended.
+	OutcomeInterrupted = OutcomeState("Interrupted")
+
+	// OutcomeIncomplete is the state of tasks which were still running when
+	// the wait ended, or could not be followed.
+	OutcomeIncomplete = OutcomeState("Incomplete")
+)
+
+// Outcome is the result of following a task with FollowTask.
+type Outcome struct {
+	State OutcomeState
+
+	// Task is the last state of the task that was seen, or nil if it was
+	// never read.
+	Task *Task
+
+	// Err is set when the task did not succeed, or could not be followed to
+	// its end.
+	Err error
+}
+
+// FollowTask waits for the task that matches the input ID to complete, as
+// WaitForTask does, and reports how it ended rather than returning an error.
+func FollowTask(client newclient.Client, spaceID string, ID string, options *WaitOptions) *Outcome {
+	return FollowTaskWithContext(context.Background(), client, spaceID, ID, options)
+}
+
+// FollowTaskWithContext is like FollowTask, but stops waiting when ctx is
+// done. If ctx was canceled with a cause, such as by a caller which gave up
+// on the task, the cause is reported as the outcome's Err.
+func FollowTaskWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, options *WaitOptions) *Outcome {
+	outcome := &Outcome{State: OutcomeIncomplete}
+
+	waitOptions := WaitOptions{}
+	if options != nil {
+		waitOptions = *options
+	}
+	onPoll := waitOptions.OnPoll
+	waitOptions.OnPoll = func(task *Task) {
+		outcome.Task = task
+		if onPoll != nil {
+			onPoll(task)
+		}
+	}
+
+	task, err := WaitForTaskWithContext(ctx, client, spaceID, ID, &waitOptions)
+	if task != nil {
+		outcome.Task = task
+	}
+
+	var taskErr *TaskError
+	switch {
+	case err == nil:
+		outcome.State = OutcomeSucceeded
+	case errors.As(err, &taskErr):
+		outcome.State = OutcomeFailed
+		outcome.Err = err
+	case outcome.Task != nil && outcome.Task.HasPendingInterruptions:
+		outcome.State = OutcomeInterrupted
+		outcome.Err = waitError(ctx, err)
+	default:
+		outcome.Err = waitError(ctx, err)
+	}
+	return outcome
+}
+
+// waitError returns the cause ctx was canceled with, if it was given one, and
+// err otherwise.
+func waitError(ctx context.Context, err error) error {
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) && !errors.Is(cause, context.DeadlineExceeded) {
+		return cause
+	}
+	return err
+}
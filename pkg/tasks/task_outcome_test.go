@@ -0,0 +1,55 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowTask(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("ids") {
+		case "ServerTasks-1":
+			writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateSuccess))
+		case "ServerTasks-2":
+			writeTasks(t, w, newTestTask("ServerTasks-2", TaskStateFailed))
+		case "ServerTasks-3":
+			task := newTestTask("ServerTasks-3", TaskStateExecuting)
+			task.HasPendingInterruptions = true
+			writeTasks(t, w, task)
+		}
+	}))
+	options := &WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond}
+
+	outcome := FollowTask(client, "Spaces-1", "ServerTasks-1", options)
+	require.Equal(t, OutcomeSucceeded, outcome.State)
+	require.Equal(t, "ServerTasks-1", outcome.Task.ID)
+	require.NoError(t, outcome.Err)
+
+	outcome = FollowTask(client, "Spaces-1", "ServerTasks-2", options)
+	require.Equal(t, OutcomeFailed, outcome.State)
+	require.ErrorIs(t, outcome.Err, ErrTaskFailed)
+
+	outcome = FollowTask(client, "Spaces-1", "ServerTasks-3", options)
+	require.Equal(t, OutcomeInterrupted, outcome.State)
+	require.Equal(t, "ServerTasks-3", outcome.Task.ID)
+	require.ErrorIs(t, outcome.Err, context.DeadlineExceeded)
+}
+
+func TestFollowTaskReportsCancellationCause(t *testing.T) {
+	cause := errors.New("no response to the prompt")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel(cause)
+		writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateExecuting))
+	}))
+
+	outcome := FollowTaskWithContext(ctx, client, "Spaces-1", "ServerTasks-1", &WaitOptions{PollInterval: time.Millisecond})
+	require.Equal(t, OutcomeIncomplete, outcome.State)
+	require.ErrorIs(t, outcome.Err, cause)
+}
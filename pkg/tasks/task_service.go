@@ -1,11 +1,20 @@
 package tasks
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/http"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
 	"github.com/dghubble/sling"
 )
 
@@ -23,6 +32,8 @@ func NewTaskService(sling *sling.Sling, uriTemplate string, taskTypesPath string
 }
 
 // Add creates a new task.
+//
+// Deprecated: use tasks.Add
 func (s *TaskService) Add(task *Task) (*Task, error) {
 	if IsNil(task) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterTask)
@@ -57,3 +68,175 @@ func (s *TaskService) Get(tasksQuery TasksQuery) (*resources.Resources[*Task], e
 
 	return response.(*resources.Resources[*Task]), nil
 }
+
+// --- new ---
+
+const (
+	template            = "/api/{spaceId}/tasks{/id}{?skip,active,environment,tenant,runbook,project,name,node,running,states,hasPendingInterruptions,hasWarningsOrErrors,take,ids,partialName,spaces,includeSystem}"
+	systemTemplate      = "/api/tasks{?skip,active,environment,tenant,runbook,project,name,node,running,states,hasPendingInterruptions,hasWarningsOrErrors,take,ids,partialName,spaces,includeSystem}"
+	taskCancelTemplate  = "/api/{spaceId}/tasks/{id}/cancel"
+	taskRerunTemplate   = "/api/{spaceId}/tasks/rerun/{id}"
+	taskDetailsTemplate = "/api/{spaceId}/tasks/{id}/details{?verbose,tail}"
+	taskRawTemplate     = "/api/{spaceId}/tasks/{id}/raw"
+)
+
+// Add queues a new task. The task's Name selects what the server does, and
+// its Arguments configure it.
+func Add(client newclient.Client, task *Task) (*Task, error) {
+	return AddWithContext(context.Background(), client, task)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, task *Task) (*Task, error) {
+	if IsNil(task) {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterTask)
+	}
+	return newclient.AddWithContext[Task](ctx, client, template, task.SpaceID, task)
+}
+
+// Get returns a collection of tasks based on the criteria defined by its input
+// query parameter.
+func Get(client newclient.Client, spaceID string, tasksQuery TasksQuery) (*resources.Resources[*Task], error) {
+	return GetWithContext(context.Background(), client, spaceID, tasksQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, tasksQuery TasksQuery) (*resources.Resources[*Task], error) {
+	return newclient.GetByQueryWithContext[Task](ctx, client, template, spaceID, tasksQuery)
+}
+
+// Iterate returns an iterator over the tasks matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query TasksQuery) iter.Seq2[*Task, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query TasksQuery) iter.Seq2[*Task, error] {
+	return newclient.IterateByQueryWithContext[Task](ctx, client, template, spaceID, query)
+}
+
+// GetByID returns the task that matches the input ID. If one cannot be found,
+// it returns nil and an error.
+func GetByID(client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return newclient.GetByIDWithContext[Task](ctx, client, template, spaceID, ID)
+}
+
+// Cancel requests cancellation of the task that matches the input ID. The
+// server cancels tasks asynchronously, so the returned task will usually be in
+// the Cancelling state.
+func Cancel(client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return CancelWithContext(context.Background(), client, spaceID, ID)
+}
+
+// CancelWithContext is like Cancel, but uses ctx to control cancellation of the HTTP requests.
+func CancelWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return postTaskAction(ctx, client, taskCancelTemplate, spaceID, ID)
+}
+
+// Rerun queues the task that matches the input ID to run again. The task must
+// have completed, and its CanRerun flag must be set.
+func Rerun(client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return RerunWithContext(context.Background(), client, spaceID, ID)
+}
+
+// RerunWithContext is like Rerun, but uses ctx to control cancellation of the HTTP requests.
+func RerunWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Task, error) {
+	return postTaskAction(ctx, client, taskRerunTemplate, spaceID, ID)
+}
+
+// GetDetails returns the task that matches the input ID along with its
+// progress and activity log tree.
+func GetDetails(client newclient.Client, spaceID string, ID string) (*TaskDetails, error) {
+	return GetDetailsWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetDetailsWithContext is like GetDetails, but uses ctx to control cancellation of the HTTP requests.
+func GetDetailsWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*TaskDetails, error) {
+	return GetDetailsByQueryWithContext(ctx, client, spaceID, ID, TaskDetailsQuery{})
+}
+
+// GetDetailsByQuery returns the task that matches the input ID along with its
+// progress and activity log tree, filtered according to the input query.
+func GetDetailsByQuery(client newclient.Client, spaceID string, ID string, detailsQuery TaskDetailsQuery) (*TaskDetails, error) {
+	return GetDetailsByQueryWithContext(context.Background(), client, spaceID, ID, detailsQuery)
+}
+
+// GetDetailsByQueryWithContext is like GetDetailsByQuery, but uses ctx to control cancellation of the HTTP requests.
+func GetDetailsByQueryWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, detailsQuery TaskDetailsQuery) (*TaskDetails, error) {
+	path, err := expandTaskPath(client, taskDetailsTemplate, spaceID, ID, detailsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[TaskDetails](ctx, client.HttpSession(), path)
+}
+
+// GetRawLog returns a reader over the plain-text log of the task that matches
+// the input ID. The caller must close the returned reader.
+func GetRawLog(client newclient.Client, spaceID string, ID string) (io.ReadCloser, error) {
+	return GetRawLogWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetRawLogWithContext is like GetRawLog, but uses ctx to control cancellation of the HTTP requests.
+func GetRawLogWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (io.ReadCloser, error) {
+	path, err := expandTaskPath(client, taskRawTemplate, spaceID, ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	resp, err := client.HttpSession().DoRawRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer newclient.CloseResponse(resp)
+		apiError := new(core.APIError)
+		_ = json.NewDecoder(resp.Body).Decode(apiError)
+		return nil, core.NewAPIError(resp, apiError)
+	}
+
+	return resp.Body, nil
+}
+
+// expandTaskPath resolves a task-scoped URI template, merging in any query
+// parameters.
+func expandTaskPath(client newclient.Client, template string, spaceID string, ID string, query any) (string, error) {
+	if internal.IsEmpty(ID) {
+		return "", internal.CreateRequiredParameterIsEmptyError(constants.ParameterID)
+	}
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return "", err
+	}
+
+	values := map[string]any{}
+	if query != nil {
+		values, _ = uritemplates.Struct2map(query)
+	}
+	values["spaceId"] = spaceID
+	values["id"] = ID
+
+	return client.URITemplateCache().Expand(template, values)
+}
+
+func postTaskAction(ctx context.Context, client newclient.Client, template string, spaceID string, ID string) (*Task, error) {
+	path, err := expandTaskPath(client, template, spaceID, ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.PostWithContext[Task](ctx, client.HttpSession(), path, nil)
+}
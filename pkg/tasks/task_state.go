@@ -0,0 +1,24 @@
+package tasks
+
+// TaskState is the execution state of a server task.
+type TaskState string
+
+const (
+	TaskStateCanceled   = TaskState("Canceled")
+	TaskStateCancelling = TaskState("Cancelling")
+	TaskStateExecuting  = TaskState("Executing")
+	TaskStateFailed     = TaskState("Failed")
+	TaskStateQueued     = TaskState("Queued")
+	TaskStateSuccess    = TaskState("Success")
+	TaskStateTimedOut   = TaskState("TimedOut")
+)
+
+// IsCompleted reports whether the state is terminal; a task in a terminal state will not change state again
+// unless it is rerun.
+func (s TaskState) IsCompleted() bool {
+	switch s {
+	case TaskStateCanceled, TaskStateFailed, TaskStateSuccess, TaskStateTimedOut:
+		return true
+	}
+	return false
+}
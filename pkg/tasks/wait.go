@@ -0,0 +1,200 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+const (
+	defaultPollInterval    = 2 * time.Second
+	defaultMaxPollInterval = 30 * time.Second
+)
+
+var (
+	// ErrTaskFailed matches (via errors.Is) a TaskError for a task which finished in the Failed state.
+	ErrTaskFailed = errors.New("task failed")
+	// ErrTaskCanceled matches (via errors.Is) a TaskError for a task which was canceled.
+	ErrTaskCanceled = errors.New("task canceled")
+	// ErrTaskTimedOut matches (via errors.Is) a TaskError for a task which timed out on the server.
+	ErrTaskTimedOut = errors.New("task timed out")
+)
+
+// TaskError is returned by WaitForTask and WaitForTasks when a task completes without succeeding.
+type TaskError struct {
+	Task *Task
+}
+
+func (e *TaskError) Error() string {
+	message := fmt.Sprintf("task %s (%s) finished with state %s", e.Task.ID, e.Task.Description, e.Task.State)
+	if e.Task.ErrorMessage != "" {
+		message += ": " + e.Task.ErrorMessage
+	}
+	return message
+}
+
+// Is allows errors.Is to match a TaskError against ErrTaskFailed, ErrTaskCanceled or ErrTaskTimedOut.
+func (e *TaskError) Is(target error) bool {
+	switch TaskState(e.Task.State) {
+	case TaskStateFailed:
+		return target == ErrTaskFailed
+	case TaskStateCanceled:
+		return target == ErrTaskCanceled
+	case TaskStateTimedOut:
+		return target == ErrTaskTimedOut
+	}
+	return false
+}
+
+// WaitOptions controls how tasks are polled while waiting for them to complete.
+type WaitOptions struct {
+	// PollInterval is the wait after the first poll; it grows by half after each subsequent poll. Defaults to 2s.
+	PollInterval time.Duration
+	// MaxPollInterval caps the wait between polls. Defaults to 30s.
+	MaxPollInterval time.Duration
+	// Timeout bounds the total time spent waiting. Zero means wait until the context is done.
+	Timeout time.Duration
+	// OnPoll, if set, is called with the latest state of each task every time it is polled.
+	OnPoll func(task *Task)
+	// IncludeSystem polls the server's task list rather than the space's, so
+	// that system tasks which belong to no space, such as the one queued by
+	// configuration.SendTestEmail, are found.
+	IncludeSystem bool
+}
+
+// WaitForTask polls the task that matches the input ID until it completes and
+// returns its final state. If the task does not succeed, the final task is
+// returned along with a *TaskError.
+func WaitForTask(client newclient.Client, spaceID string, ID string, options *WaitOptions) (*Task, error) {
+	return WaitForTaskWithContext(context.Background(), client, spaceID, ID, options)
+}
+
+// WaitForTaskWithContext is like WaitForTask, but stops waiting when ctx is done.
+func WaitForTaskWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string, options *WaitOptions) (*Task, error) {
+	completedTasks, err := WaitForTasksWithContext(ctx, client, spaceID, []string{ID}, options)
+	if len(completedTasks) == 0 {
+		return nil, err
+	}
+	return completedTasks[0], err
+}
+
+// WaitForTasks polls the tasks that match the input IDs until all of them
+// complete, and returns their final states in the same order as the IDs. If
+// any task does not succeed, the final tasks are returned along with a
+// *TaskError for the first unsuccessful task.
+func WaitForTasks(client newclient.Client, spaceID string, IDs []string, options *WaitOptions) ([]*Task, error) {
+	return WaitForTasksWithContext(context.Background(), client, spaceID, IDs, options)
+}
+
+// WaitForTasksWithContext is like WaitForTasks, but stops waiting when ctx is done.
+func WaitForTasksWithContext(ctx context.Context, client newclient.Client, spaceID string, IDs []string, options *WaitOptions) ([]*Task, error) {
+	if len(IDs) == 0 {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("IDs")
+	}
+	for _, ID := range IDs {
+		if internal.IsEmpty(ID) {
+			return nil, internal.CreateRequiredParameterIsEmptyError("IDs")
+		}
+	}
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	maxPollInterval := options.MaxPollInterval
+	if maxPollInterval <= 0 {
+		maxPollInterval = defaultMaxPollInterval
+	}
+
+	uniqueIDs := map[string]bool{}
+	for _, ID := range IDs {
+		uniqueIDs[ID] = true
+	}
+
+	completed := map[string]*Task{}
+	for {
+		pending := make([]string, 0, len(uniqueIDs))
+		for ID := range uniqueIDs {
+			if completed[ID] == nil {
+				pending = append(pending, ID)
+			}
+		}
+
+		polled, err := pollTasks(ctx, client, spaceID, TasksQuery{IDs: pending, Take: len(pending)}, options.IncludeSystem)
+		if err != nil {
+			return nil, err
+		}
+		found := map[string]bool{}
+		for _, task := range polled.Items {
+			found[task.ID] = true
+			if options.OnPoll != nil {
+				options.OnPoll(task)
+			}
+			if TaskState(task.State).IsCompleted() {
+				completed[task.ID] = task
+			}
+		}
+		for _, ID := range pending {
+			if !found[ID] {
+				return nil, internal.CreateResourceNotFoundError("task", "ID", ID)
+			}
+		}
+
+		if len(completed) == len(uniqueIDs) {
+			break
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		pollInterval += pollInterval / 2
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+
+	results := make([]*Task, len(IDs))
+	var taskErr error
+	for i, ID := range IDs {
+		results[i] = completed[ID]
+		if taskErr == nil && TaskState(results[i].State) != TaskStateSuccess {
+			taskErr = &TaskError{Task: results[i]}
+		}
+	}
+	return results, taskErr
+}
+
+// pollTasks returns the tasks matching query, from the tasks of the space or,
+// if includeSystem is set, from every task of the server.
+func pollTasks(ctx context.Context, client newclient.Client, spaceID string, query TasksQuery, includeSystem bool) (*resources.Resources[*Task], error) {
+	if !includeSystem {
+		return GetWithContext(ctx, client, spaceID, query)
+	}
+
+	query.IncludeSystem = true
+	values, _ := uritemplates.Struct2map(query)
+	path, err := client.URITemplateCache().Expand(systemTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[resources.Resources[*Task]](ctx, client.HttpSession(), path)
+}
@@ -0,0 +1,134 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTasks(t *testing.T, w http.ResponseWriter, tasks ...*Task) {
+	require.NoError(t, json.NewEncoder(w).Encode(&resources.Resources[*Task]{Items: tasks}))
+}
+
+func newTestTask(id string, state TaskState) *Task {
+	task := NewTask()
+	task.ID = id
+	task.State = string(state)
+	return task
+}
+
+func TestWaitForTaskPollsUntilCompleted(t *testing.T) {
+	var polls int32
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/tasks", r.URL.Path)
+		require.Equal(t, "ServerTasks-1", r.URL.Query().Get("ids"))
+		if atomic.AddInt32(&polls, 1) < 3 {
+			writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateExecuting))
+			return
+		}
+		writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateSuccess))
+	}))
+
+	task, err := WaitForTask(client, "Spaces-1", "ServerTasks-1", &WaitOptions{PollInterval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, string(TaskStateSuccess), task.State)
+	require.Equal(t, int32(3), polls)
+}
+
+func TestWaitForTasksReturnsTaskError(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		var tasks []*Task
+		for _, id := range ids {
+			switch id {
+			case "ServerTasks-1":
+				tasks = append(tasks, newTestTask(id, TaskStateSuccess))
+			case "ServerTasks-2":
+				tasks = append(tasks, newTestTask(id, TaskStateCanceled))
+			}
+		}
+		writeTasks(t, w, tasks...)
+	}))
+
+	completed, err := WaitForTasks(client, "Spaces-1", []string{"ServerTasks-1", "ServerTasks-2"}, nil)
+	require.Len(t, completed, 2)
+	require.Equal(t, "ServerTasks-1", completed[0].ID)
+	require.Equal(t, "ServerTasks-2", completed[1].ID)
+
+	require.True(t, errors.Is(err, ErrTaskCanceled))
+	require.False(t, errors.Is(err, ErrTaskFailed))
+
+	var taskErr *TaskError
+	require.True(t, errors.As(err, &taskErr))
+	require.Equal(t, "ServerTasks-2", taskErr.Task.ID)
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateQueued))
+	}))
+
+	task, err := WaitForTask(client, "Spaces-1", "ServerTasks-1", &WaitOptions{PollInterval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	require.Nil(t, task)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForSystemTask(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/tasks", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("includeSystem"))
+		require.Equal(t, "ServerTasks-1", r.URL.Query().Get("ids"))
+		writeTasks(t, w, newTestTask("ServerTasks-1", TaskStateSuccess))
+	}))
+
+	task, err := WaitForTask(client, "", "ServerTasks-1", &WaitOptions{IncludeSystem: true})
+	require.NoError(t, err)
+	require.Equal(t, "ServerTasks-1", task.ID)
+}
+
+func TestWaitForTaskNotFound(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeTasks(t, w)
+	}))
+
+	task, err := WaitForTask(client, "Spaces-1", "ServerTasks-1", nil)
+	require.Nil(t, task)
+	require.Error(t, err)
+}
+
+func TestCancel(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/Spaces-1/tasks/ServerTasks-1/cancel", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(newTestTask("ServerTasks-1", TaskStateCancelling)))
+	}))
+
+	task, err := Cancel(client, "", "ServerTasks-1")
+	require.NoError(t, err)
+	require.Equal(t, string(TaskStateCancelling), task.State)
+
+	task, err = Cancel(client, "", "")
+	require.Error(t, err)
+	require.Nil(t, task)
+}
+
+func TestRerun(t *testing.T) {
+	client := testutil.NewTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/Spaces-1/tasks/rerun/ServerTasks-1", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(newTestTask("ServerTasks-1", TaskStateQueued)))
+	}))
+
+	task, err := Rerun(client, "Spaces-1", "ServerTasks-1")
+	require.NoError(t, err)
+	require.Equal(t, string(TaskStateQueued), task.State)
+}
@@ -1,8 +1,18 @@
 package teammembership
 
 import (
+	"context"
+	"slices"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/permissions"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
 	"github.com/dghubble/sling"
 )
 
@@ -18,3 +28,154 @@ func NewTeamMembershipService(sling *sling.Sling, uriTemplate string, previewTea
 		Service:         services.NewService(constants.ServiceTeamMembershipService, sling, uriTemplate),
 	}
 }
+
+// Get returns the teams the user of the input query belongs to, either
+// directly or through an external security group, in the spaces of the
+// query.
+//
+// Deprecated: use teammembership.Get
+func (s *TeamMembershipService) Get(query teams.TeamMembershipQuery) ([]*permissions.ProjectedTeamReferenceDataItem, error) {
+	if internal.IsEmpty(query.UserID) {
+		return nil, internal.CreateInvalidParameterError("Get", "userID")
+	}
+
+	if err := services.ValidateInternalState(s); err != nil {
+		return nil, err
+	}
+
+	path, err := s.GetURITemplate().Expand(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.ApiGet(s.GetClient(), new([]*permissions.ProjectedTeamReferenceDataItem), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return *resp.(*[]*permissions.ProjectedTeamReferenceDataItem), nil
+}
+
+// PreviewTeam returns the users who would be members of the input team,
+// including those who belong through its external security groups. The team
+// does not need to be saved.
+//
+// Deprecated: use teammembership.PreviewTeam
+func (s *TeamMembershipService) PreviewTeam(team *teams.Team) ([]*users.User, error) {
+	if team == nil {
+		return nil, internal.CreateInvalidParameterError("PreviewTeam", constants.ParameterTeam)
+	}
+
+	if internal.IsEmpty(s.previewTeamPath) {
+		return nil, internal.CreateInvalidPathError(s.GetName())
+	}
+
+	resp, err := services.ApiPost(s.GetClient(), team, new([]*users.User), s.previewTeamPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return *resp.(*[]*users.User), nil
+}
+
+// --- new ---
+
+const (
+	template            = "/api/teammembership{?userId,spaces,includeSystem}"
+	previewTeamTemplate = "/api/teammembership/previewteam"
+)
+
+// Get returns the teams the user of the input query belongs to, either
+// directly or through an external security group, in the spaces of the
+// query. Set IncludeSystem to include teams which are not scoped to a space.
+func Get(client newclient.Client, query teams.TeamMembershipQuery) ([]*permissions.ProjectedTeamReferenceDataItem, error) {
+	return GetWithContext(context.Background(), client, query)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, query teams.TeamMembershipQuery) ([]*permissions.ProjectedTeamReferenceDataItem, error) {
+	if internal.IsEmpty(query.UserID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError("userID")
+	}
+
+	path, err := client.URITemplateCache().Expand(template, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := newclient.GetWithContext[[]*permissions.ProjectedTeamReferenceDataItem](ctx, client.HttpSession(), path)
+	if err != nil {
+		return nil, err
+	}
+	return *resp, nil
+}
+
+// GetBySpace returns the teams a user belongs to, grouped by the ID of the
+// space each team is scoped to. Teams which are not scoped to a space are
+// grouped under the empty string.
+func GetBySpace(client newclient.Client, userID string) (map[string][]*permissions.ProjectedTeamReferenceDataItem, error) {
+	return GetBySpaceWithContext(context.Background(), client, userID)
+}
+
+// GetBySpaceWithContext is like GetBySpace, but uses ctx to control cancellation of the HTTP requests.
+func GetBySpaceWithContext(ctx context.Context, client newclient.Client, userID string) (map[string][]*permissions.ProjectedTeamReferenceDataItem, error) {
+	memberships, err := GetWithContext(ctx, client, teams.TeamMembershipQuery{UserID: userID, IncludeSystem: true})
+	if err != nil {
+		return nil, err
+	}
+
+	bySpace := map[string][]*permissions.ProjectedTeamReferenceDataItem{}
+	for _, membership := range memberships {
+		bySpace[membership.SpaceID] = append(bySpace[membership.SpaceID], membership)
+	}
+	return bySpace, nil
+}
+
+// TeamPreview is the effective membership of a team.
+type TeamPreview struct {
+	Team *teams.Team
+
+	// Members are the users who belong to the team, either directly or
+	// through one of its external security groups.
+	Members []*users.User
+
+	// ExternalSecurityGroups are the groups, such as Active Directory
+	// groups, whose members belong to the team.
+	ExternalSecurityGroups []core.NamedReferenceItem
+}
+
+// MembersThroughExternalSecurityGroups returns the members of the team who
+// are not direct members, and so belong through an external security group.
+func (p *TeamPreview) MembersThroughExternalSecurityGroups() []*users.User {
+	members := []*users.User{}
+	for _, member := range p.Members {
+		if !slices.Contains(p.Team.MemberUserIDs, member.GetID()) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// PreviewTeam returns the effective membership of the input team, including
+// the users who belong through its external security groups. The team does
+// not need to be saved, so changes can be previewed before they are made.
+func PreviewTeam(client newclient.Client, team *teams.Team) (*TeamPreview, error) {
+	return PreviewTeamWithContext(context.Background(), client, team)
+}
+
+// PreviewTeamWithContext is like PreviewTeam, but uses ctx to control cancellation of the HTTP requests.
+func PreviewTeamWithContext(ctx context.Context, client newclient.Client, team *teams.Team) (*TeamPreview, error) {
+	if team == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterTeam)
+	}
+
+	members, err := newclient.PostWithContext[[]*users.User](ctx, client.HttpSession(), previewTeamTemplate, team)
+	if err != nil {
+		return nil, err
+	}
+	return &TeamPreview{
+		Team:                   team,
+		Members:                *members,
+		ExternalSecurityGroups: team.ExternalSecurityGroups,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package teammembership
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBySpace(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/teammembership", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Users-1", r.URL.Query().Get("userId"))
+		require.Equal(t, "true", r.URL.Query().Get("includeSystem"))
+		_, _ = w.Write([]byte(`[
+			{"Id": "Teams-1", "Name": "Everyone", "IsDirectlyAssigned": false},
+			{"Id": "Teams-2", "Name": "Operators", "SpaceId": "Spaces-1", "IsDirectlyAssigned": true},
+			{"Id": "Teams-3", "Name": "Developers", "SpaceId": "Spaces-1", "IsDirectlyAssigned": false, "ExternalSecurityGroups": [{"Id": "S-1-5-21", "DisplayName": "Developers"}]},
+			{"Id": "Teams-4", "Name": "Auditors", "SpaceId": "Spaces-2", "IsDirectlyAssigned": true}
+		]`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	bySpace, err := GetBySpace(client, "Users-1")
+	require.NoError(t, err)
+	require.Len(t, bySpace, 3)
+	require.Equal(t, "Everyone", bySpace[""][0].Name)
+	require.Len(t, bySpace["Spaces-1"], 2)
+	require.Equal(t, "Developers", bySpace["Spaces-1"][1].ExternalSecurityGroups[0].DisplayName)
+	require.Equal(t, "Auditors", bySpace["Spaces-2"][0].Name)
+}
+
+func TestPreviewTeam(t *testing.T) {
+	var previewed map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/teammembership/previewteam", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&previewed))
+		_, _ = w.Write([]byte(`[{"Id": "Users-1", "Username": "alice"}, {"Id": "Users-2", "Username": "bob"}]`))
+	})
+	client := testutil.NewTestClient(t, mux)
+
+	team := teams.NewTeam("Developers")
+	team.MemberUserIDs = []string{"Users-1"}
+	team.ExternalSecurityGroups = []core.NamedReferenceItem{{ID: "S-1-5-21", DisplayName: "Developers"}}
+
+	preview, err := PreviewTeam(client, team)
+	require.NoError(t, err)
+	require.Equal(t, "Developers", previewed["Name"])
+	require.Len(t, preview.Members, 2)
+	require.Equal(t, team.ExternalSecurityGroups, preview.ExternalSecurityGroups)
+
+	viaGroups := preview.MembersThroughExternalSecurityGroups()
+	require.Len(t, viaGroups, 1)
+	require.Equal(t, "bob", viaGroups[0].Username)
+}
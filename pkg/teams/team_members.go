@@ -0,0 +1,87 @@
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// ErrTeamModified is returned by AddMembers and RemoveMembers when the team
+// on the server has been modified since the caller read it. The caller
+// should read the team again and decide whether the change still applies.
+var ErrTeamModified = errors.New("the team has been modified since it was read")
+
+// AddMembers adds users to a team. The team passed in is the copy the caller
+// read; if the team on the server has been modified since, ErrTeamModified
+// is returned and nothing is changed. Users who are already members are
+// ignored. It returns the updated team.
+//
+// The check is made immediately before the update, so it narrows but cannot
+// close the window for a concurrent change to be overwritten.
+func AddMembers(client newclient.Client, team *Team, userIDs ...string) (*Team, error) {
+	return AddMembersWithContext(context.Background(), client, team, userIDs...)
+}
+
+// AddMembersWithContext is like AddMembers, but uses ctx to control cancellation of the HTTP requests.
+func AddMembersWithContext(ctx context.Context, client newclient.Client, team *Team, userIDs ...string) (*Team, error) {
+	return changeMembers(ctx, client, team, func(memberUserIDs []string) []string {
+		for _, userID := range userIDs {
+			if !internal.IsEmpty(userID) && !slices.Contains(memberUserIDs, userID) {
+				memberUserIDs = append(memberUserIDs, userID)
+			}
+		}
+		return memberUserIDs
+	})
+}
+
+// RemoveMembers removes users from a team, with the same check for
+// concurrent modification as AddMembers. Users who are not members are
+// ignored. It returns the updated team.
+func RemoveMembers(client newclient.Client, team *Team, userIDs ...string) (*Team, error) {
+	return RemoveMembersWithContext(context.Background(), client, team, userIDs...)
+}
+
+// RemoveMembersWithContext is like RemoveMembers, but uses ctx to control cancellation of the HTTP requests.
+func RemoveMembersWithContext(ctx context.Context, client newclient.Client, team *Team, userIDs ...string) (*Team, error) {
+	return changeMembers(ctx, client, team, func(memberUserIDs []string) []string {
+		return slices.DeleteFunc(memberUserIDs, func(userID string) bool {
+			return slices.Contains(userIDs, userID)
+		})
+	})
+}
+
+func changeMembers(ctx context.Context, client newclient.Client, team *Team, change func(memberUserIDs []string) []string) (*Team, error) {
+	if IsNil(team) {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterTeam)
+	}
+
+	current, err := GetByIDWithContext(ctx, client, team.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if !isSameVersion(team, current) {
+		return nil, fmt.Errorf("%w: team %s was last modified by %s", ErrTeamModified, current.GetID(), current.GetModifiedBy())
+	}
+	if !current.CanChangeMembers {
+		return nil, fmt.Errorf("the members of team %s cannot be changed", current.GetID())
+	}
+
+	memberUserIDs := change(slices.Clone(current.MemberUserIDs))
+	if slices.Equal(memberUserIDs, current.MemberUserIDs) {
+		return current, nil
+	}
+	current.MemberUserIDs = memberUserIDs
+	return UpdateWithContext(ctx, client, current)
+}
+
+func isSameVersion(read *Team, current *Team) bool {
+	if read.GetModifiedOn() == nil || current.GetModifiedOn() == nil {
+		return read.GetModifiedOn() == current.GetModifiedOn()
+	}
+	return read.GetModifiedOn().Equal(*current.GetModifiedOn())
+}
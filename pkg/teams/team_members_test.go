@@ -0,0 +1,64 @@
+package teams
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+const serverTeam = `{"Id": "Teams-1", "Name": "Operators", "CanChangeMembers": true, "MemberUserIds": ["Users-1", "Users-2"], "LastModifiedOn": "2026-10-01T00:00:00Z", "LastModifiedBy": "admin"}`
+
+func newMembersTestServer(t *testing.T, updated *Team) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/teams/Teams-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(serverTeam))
+	})
+	mux.HandleFunc("PUT /api/teams/Teams-1", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(updated))
+		_, _ = w.Write([]byte(serverTeam))
+	})
+	return mux
+}
+
+func readTeam(t *testing.T) *Team {
+	team := new(Team)
+	require.NoError(t, json.Unmarshal([]byte(serverTeam), team))
+	return team
+}
+
+func TestAddMembers(t *testing.T) {
+	updated := new(Team)
+	client := testutil.NewTestClient(t, newMembersTestServer(t, updated))
+
+	_, err := AddMembers(client, readTeam(t), "Users-2", "Users-3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Users-1", "Users-2", "Users-3"}, updated.MemberUserIDs)
+	require.Equal(t, "Operators", updated.Name)
+}
+
+func TestRemoveMembers(t *testing.T) {
+	updated := new(Team)
+	client := testutil.NewTestClient(t, newMembersTestServer(t, updated))
+
+	_, err := RemoveMembers(client, readTeam(t), "Users-1", "Users-4")
+	require.NoError(t, err)
+	require.Equal(t, []string{"Users-2"}, updated.MemberUserIDs)
+}
+
+func TestAddMembersRejectsStaleTeam(t *testing.T) {
+	updated := new(Team)
+	client := testutil.NewTestClient(t, newMembersTestServer(t, updated))
+
+	stale := readTeam(t)
+	modifiedOn := stale.GetModifiedOn().Add(-time.Hour)
+	stale.SetModifiedOn(&modifiedOn)
+
+	_, err := AddMembers(client, stale, "Users-3")
+	require.True(t, errors.Is(err, ErrTeamModified))
+	require.Nil(t, updated.MemberUserIDs)
+}
@@ -1,9 +1,12 @@
 package teams
 
 import (
+	"context"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
@@ -150,3 +153,44 @@ func (s *TeamService) GetScopedUserRoles(team Team, query core.SkipTakeQuery) (*
 
 	return resp.(*resources.Resources[*userroles.ScopedUserRole]), nil
 }
+
+// --- new ---
+
+const template = "/api/teams{/id}{?skip,take,ids,partialName,spaces,includeSystem}"
+
+// GetByID returns the team that matches the input ID. If one cannot be found,
+// it returns nil and an error.
+func GetByID(client newclient.Client, ID string) (*Team, error) {
+	return GetByIDWithContext(context.Background(), client, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, ID string) (*Team, error) {
+	if internal.IsEmpty(ID) {
+		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
+	}
+
+	path, err := client.URITemplateCache().Expand(template, map[string]any{"id": ID})
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[Team](ctx, client.HttpSession(), path)
+}
+
+// Update modifies a team based on the one provided as input.
+func Update(client newclient.Client, team *Team) (*Team, error) {
+	return UpdateWithContext(context.Background(), client, team)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, team *Team) (*Team, error) {
+	if IsNil(team) {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterTeam)
+	}
+
+	path, err := client.URITemplateCache().Expand(template, map[string]any{"id": team.GetID()})
+	if err != nil {
+		return nil, err
+	}
+	return newclient.PutWithContext[Team](ctx, client.HttpSession(), path, team)
+}
@@ -1,6 +1,8 @@
 package tenants
 
 import (
+	"context"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -287,30 +289,72 @@ const template = "/api/{spaceId}/tenants{/id}{?skip,projectId,name,tags,take,ids
 // Get returns a collection of tenants based on the criteria defined by its
 // input query parameter.
 func Get(client newclient.Client, spaceID string, tenantsQuery TenantsQuery) (*resources.Resources[*Tenant], error) {
-	return newclient.GetByQuery[Tenant](client, template, spaceID, tenantsQuery)
+	return GetWithContext(context.Background(), client, spaceID, tenantsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, tenantsQuery TenantsQuery) (*resources.Resources[*Tenant], error) {
+	return newclient.GetByQueryWithContext[Tenant](ctx, client, template, spaceID, tenantsQuery)
+}
+
+// Iterate returns an iterator over the tenants matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query TenantsQuery) iter.Seq2[*Tenant, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query TenantsQuery) iter.Seq2[*Tenant, error] {
+	return newclient.IterateByQueryWithContext[Tenant](ctx, client, template, spaceID, query)
 }
 
 // Update modifies a tenant based on the one provided as input.
 func Update(client newclient.Client, resource *Tenant) (*Tenant, error) {
-	return newclient.Update[Tenant](client, template, resource.SpaceID, resource.ID, resource)
+	return UpdateWithContext(context.Background(), client, resource)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, resource *Tenant) (*Tenant, error) {
+	return newclient.UpdateWithContext[Tenant](ctx, client, template, resource.SpaceID, resource.ID, resource)
 }
 
 // Add creates a new Tenant.
 func Add(client newclient.Client, tenant *Tenant) (*Tenant, error) {
-	return newclient.Add[Tenant](client, template, tenant.SpaceID, tenant)
+	return AddWithContext(context.Background(), client, tenant)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, tenant *Tenant) (*Tenant, error) {
+	return newclient.AddWithContext[Tenant](ctx, client, template, tenant.SpaceID, tenant)
 }
 
 // GetByID returns the tenant that matches the input ID.
 func GetByID(client newclient.Client, spaceID string, ID string) (*Tenant, error) {
-	return newclient.GetByID[Tenant](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*Tenant, error) {
+	return newclient.GetByIDWithContext[Tenant](ctx, client, template, spaceID, ID)
 }
 
 // DeleteByID deletes the tenant that matches the input ID.
 func DeleteByID(client newclient.Client, spaceID string, ID string) error {
-	return newclient.DeleteByID(client, template, spaceID, ID)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, ID)
 }
 
 // GetAll returns all tenants. If an error occurs, it returns nil.
 func GetAll(client newclient.Client, spaceID string) ([]*Tenant, error) {
-	return newclient.GetAll[Tenant](client, template, spaceID)
+	return GetAllWithContext(context.Background(), client, spaceID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string) ([]*Tenant, error) {
+	return newclient.GetAllWithContext[Tenant](ctx, client, template, spaceID)
 }
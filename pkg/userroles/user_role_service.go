@@ -1,6 +1,9 @@
 package userroles
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -127,6 +130,11 @@ func (s *UserRoleService) Update(userRole *UserRole) (*UserRole, error) {
 
 // Add creates a new user role.
 func Add(client newclient.Client, userRole *UserRole) (*UserRole, error) {
+	return AddWithContext(context.Background(), client, userRole)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, userRole *UserRole) (*UserRole, error) {
 	if IsNil(userRole) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterUserRole)
 	}
@@ -138,7 +146,7 @@ func Add(client newclient.Client, userRole *UserRole) (*UserRole, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Post[UserRole](client.HttpSession(), expandedUri, userRole)
+	resp, err := newclient.PostWithContext[UserRole](ctx, client.HttpSession(), expandedUri, userRole)
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +158,11 @@ func Add(client newclient.Client, userRole *UserRole) (*UserRole, error) {
 // input query parameter. If an error occurs, an empty collection is returned
 // along with the associated error.
 func Get(client newclient.Client, spaceID string, userRolesQuery UserRolesQuery) (*resources.Resources[*UserRole], error) {
+	return GetWithContext(context.Background(), client, spaceID, userRolesQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, userRolesQuery UserRolesQuery) (*resources.Resources[*UserRole], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -166,7 +179,7 @@ func Get(client newclient.Client, spaceID string, userRolesQuery UserRolesQuery)
 		return nil, err
 	}
 
-	resp, err := newclient.Get[resources.Resources[*UserRole]](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[resources.Resources[*UserRole]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*UserRole]{}, err
 	}
@@ -174,9 +187,26 @@ func Get(client newclient.Client, spaceID string, userRolesQuery UserRolesQuery)
 	return resp, nil
 }
 
+// Iterate returns an iterator over the user roles matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query UserRolesQuery) iter.Seq2[*UserRole, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query UserRolesQuery) iter.Seq2[*UserRole, error] {
+	return newclient.IterateByQueryWithContext[UserRole](ctx, client, userRolesTemplate, spaceID, query)
+}
+
 // GetByID returns the user role that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, id string) (*UserRole, error) {
+	return GetByIDWithContext(context.Background(), client, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, id string) (*UserRole, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -188,7 +218,7 @@ func GetByID(client newclient.Client, id string) (*UserRole, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Get[UserRole](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[UserRole](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +228,11 @@ func GetByID(client newclient.Client, id string) (*UserRole, error) {
 
 // Update modifies a user role based on the one provided as input.
 func Update(client newclient.Client, userRole *UserRole) (*UserRole, error) {
+	return UpdateWithContext(context.Background(), client, userRole)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, userRole *UserRole) (*UserRole, error) {
 	if userRole == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterUserRole)
 	}
@@ -209,7 +244,7 @@ func Update(client newclient.Client, userRole *UserRole) (*UserRole, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Put[UserRole](client.HttpSession(), expandedUri, userRole)
+	resp, err := newclient.PutWithContext[UserRole](ctx, client.HttpSession(), expandedUri, userRole)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +254,11 @@ func Update(client newclient.Client, userRole *UserRole) (*UserRole, error) {
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -230,5 +270,5 @@ func DeleteByID(client newclient.Client, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
@@ -43,4 +43,18 @@ func NewAPIKey(purpose string, userID string) *CreateAPIKey {
 	}
 }
 
+// NewAPIKeyWithExpiry initializes an API key with a purpose, which the
+// server rejects after it expires.
+func NewAPIKeyWithExpiry(purpose string, userID string, expires time.Time) *CreateAPIKey {
+	apiKey := NewAPIKey(purpose, userID)
+	apiKey.Expires = &expires
+	return apiKey
+}
+
+// ExpiresBefore reports whether the API key expires before t. API keys
+// without an expiry never expire.
+func (k *APIKey) ExpiresBefore(t time.Time) bool {
+	return k.Expires != nil && k.Expires.Before(t)
+}
+
 var _ resources.IResource = &APIKey{}
@@ -0,0 +1,129 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+// ExpiringAPIKey is an API key of a service account which expires soon.
+type ExpiringAPIKey struct {
+	User   *User
+	APIKey *APIKey
+}
+
+// GetServiceAccountAPIKeysExpiringBefore returns the API keys of every
+// service account which expire before t, including those which have already
+// expired. API keys without an expiry are never returned.
+func GetServiceAccountAPIKeysExpiringBefore(client newclient.Client, t time.Time) ([]*ExpiringAPIKey, error) {
+	return GetServiceAccountAPIKeysExpiringBeforeWithContext(context.Background(), client, t)
+}
+
+// GetServiceAccountAPIKeysExpiringBeforeWithContext is like GetServiceAccountAPIKeysExpiringBefore, but uses ctx to control cancellation of the HTTP requests.
+func GetServiceAccountAPIKeysExpiringBeforeWithContext(ctx context.Context, client newclient.Client, t time.Time) ([]*ExpiringAPIKey, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+
+	// users are not scoped to a space, so the collection is walked directly
+	path, err := client.URITemplateCache().Expand(usersTemplate, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	expiring := []*ExpiringAPIKey{}
+	for user, err := range newclient.IterateByPathWithContext[User](ctx, client, path) {
+		if err != nil {
+			return nil, err
+		}
+		if !user.IsService {
+			continue
+		}
+		for apiKey, err := range IterateAPIKeysWithContext(ctx, client, user.GetID()) {
+			if err != nil {
+				return nil, err
+			}
+			if apiKey.ExpiresBefore(t) {
+				expiring = append(expiring, &ExpiringAPIKey{User: user, APIKey: apiKey})
+			}
+		}
+	}
+	return expiring, nil
+}
+
+// RotateOptions controls the replacement key created by RotateAPIKey.
+type RotateOptions struct {
+	// Purpose of the replacement key. Defaults to the purpose of the key
+	// being rotated.
+	Purpose string
+
+	// Expires is when the replacement key expires. If nil, the replacement
+	// is given the same lifetime as the key being rotated, or no expiry if
+	// that key has none.
+	Expires *time.Time
+}
+
+// RotateAPIKey replaces an API key of a user. It creates a replacement key,
+// passes it to store so the caller can save the new value, and revokes the
+// old key only once store succeeds. If store fails, the replacement key is
+// revoked and the old key is left in place.
+//
+// If the old key cannot be revoked, the replacement is returned along with
+// the error, as it has already been stored.
+func RotateAPIKey(client newclient.Client, userID string, apiKeyID string, options *RotateOptions, store func(apiKey *CreateAPIKey) error) (*CreateAPIKey, error) {
+	return RotateAPIKeyWithContext(context.Background(), client, userID, apiKeyID, options, store)
+}
+
+// RotateAPIKeyWithContext is like RotateAPIKey, but uses ctx to control cancellation of the HTTP requests.
+func RotateAPIKeyWithContext(ctx context.Context, client newclient.Client, userID string, apiKeyID string, options *RotateOptions, store func(apiKey *CreateAPIKey) error) (*CreateAPIKey, error) {
+	if client == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("client")
+	}
+	if store == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError("store")
+	}
+	if options == nil {
+		options = &RotateOptions{}
+	}
+
+	old, err := GetAPIKeyByIDWithContext(ctx, client, userID, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := NewAPIKey(old.Purpose, userID)
+	if !internal.IsEmpty(options.Purpose) {
+		replacement.Purpose = options.Purpose
+	}
+	switch {
+	case options.Expires != nil:
+		replacement.Expires = options.Expires
+	case old.Expires != nil && old.Created != nil:
+		expires := time.Now().Add(old.Expires.Sub(*old.Created))
+		replacement.Expires = &expires
+	}
+
+	created, err := AddAPIKeyWithContext(ctx, client, replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store(created); err != nil {
+		// the old key is still in use, so the unsaved replacement is discarded,
+		// even if ctx was canceled while it was being stored
+		err = fmt.Errorf("cannot store API key %s: %w", created.GetID(), err)
+		if revokeErr := RevokeAPIKeyWithContext(context.WithoutCancel(ctx), client, userID, created.GetID()); revokeErr != nil {
+			return nil, errors.Join(err, revokeErr)
+		}
+		return nil, err
+	}
+
+	if err := RevokeAPIKeyWithContext(ctx, client, userID, old.GetID()); err != nil {
+		return created, err
+	}
+	return created, nil
+}
@@ -0,0 +1,111 @@
+package users
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPIKeyServer holds the API keys of a service account, and records
+// those which are created and revoked.
+type fakeAPIKeyServer struct {
+	created *CreateAPIKey
+	revoked []string
+}
+
+func (s *fakeAPIKeyServer) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/users", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items": [{"Id": "Users-1", "IsService": true}, {"Id": "Users-2", "IsService": false}], "Links": {}}`))
+	})
+	mux.HandleFunc("GET /api/users/Users-1/apikeys", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items": [
+			{"Id": "APIKeys-1", "Purpose": "deploy", "Created": "2026-01-01T00:00:00Z", "Expires": "2026-04-01T00:00:00Z"},
+			{"Id": "APIKeys-2", "Purpose": "build", "Expires": "2027-01-01T00:00:00Z"},
+			{"Id": "APIKeys-3", "Purpose": "forever"}
+		], "Links": {}}`))
+	})
+	mux.HandleFunc("GET /api/users/Users-2/apikeys", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API keys of users which are not service accounts should not be read")
+	})
+	mux.HandleFunc("GET /api/users/Users-1/apikeys/APIKeys-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Id": "APIKeys-1", "Purpose": "deploy", "Created": "2026-01-01T00:00:00Z", "Expires": "2026-04-01T00:00:00Z"}`))
+	})
+	mux.HandleFunc("POST /api/users/Users-1/apikeys", func(w http.ResponseWriter, r *http.Request) {
+		s.created = new(CreateAPIKey)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(s.created))
+		_, _ = w.Write([]byte(`{"Id": "APIKeys-4", "ApiKey": "API-NEWKEY", "Purpose": "deploy"}`))
+	})
+	mux.HandleFunc("DELETE /api/users/Users-1/apikeys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.revoked = append(s.revoked, r.PathValue("id"))
+		w.WriteHeader(http.StatusOK)
+	})
+	return mux
+}
+
+func TestGetServiceAccountAPIKeysExpiringBefore(t *testing.T) {
+	client := testutil.NewTestClient(t, (&fakeAPIKeyServer{}).handler(t))
+
+	expiring, err := GetServiceAccountAPIKeysExpiringBefore(client, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, expiring, 1)
+	require.Equal(t, "Users-1", expiring[0].User.GetID())
+	require.Equal(t, "APIKeys-1", expiring[0].APIKey.GetID())
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	server := &fakeAPIKeyServer{}
+	client := testutil.NewTestClient(t, server.handler(t))
+
+	var stored string
+	replacement, err := RotateAPIKey(client, "Users-1", "APIKeys-1", nil, func(apiKey *CreateAPIKey) error {
+		require.Empty(t, server.revoked, "the old key must not be revoked before the replacement is stored")
+		stored = apiKey.APIKey
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "API-NEWKEY", stored)
+	require.Equal(t, "APIKeys-4", replacement.GetID())
+	require.Equal(t, []string{"APIKeys-1"}, server.revoked)
+
+	// the replacement has the same purpose and lifetime as the old key
+	require.Equal(t, "deploy", server.created.Purpose)
+	require.NotNil(t, server.created.Expires)
+	require.WithinDuration(t, time.Now().Add(90*24*time.Hour), *server.created.Expires, time.Minute)
+}
+
+func TestRotateAPIKeyRevokesReplacementWhenStoreFails(t *testing.T) {
+	server := &fakeAPIKeyServer{}
+	client := testutil.NewTestClient(t, server.handler(t))
+
+	errStore := errors.New("vault unavailable")
+	expires := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	replacement, err := RotateAPIKey(client, "Users-1", "APIKeys-1", &RotateOptions{Purpose: "deploy (rotated)", Expires: &expires}, func(apiKey *CreateAPIKey) error {
+		return errStore
+	})
+	require.ErrorIs(t, err, errStore)
+	require.Nil(t, replacement)
+	require.Equal(t, []string{"APIKeys-4"}, server.revoked)
+	require.Equal(t, "deploy (rotated)", server.created.Purpose)
+	require.True(t, expires.Equal(*server.created.Expires))
+}
+
+func TestRotateAPIKeyRevokesReplacementWhenCanceledWhileStoring(t *testing.T) {
+	server := &fakeAPIKeyServer{}
+	client := testutil.NewTestClient(t, server.handler(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	replacement, err := RotateAPIKeyWithContext(ctx, client, "Users-1", "APIKeys-1", nil, func(apiKey *CreateAPIKey) error {
+		cancel()
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, replacement)
+	require.Equal(t, []string{"APIKeys-4"}, server.revoked)
+}
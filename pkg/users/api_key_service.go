@@ -1,10 +1,13 @@
 package users
 
 import (
+	"context"
 	"fmt"
+	"iter"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
@@ -101,3 +104,121 @@ func (s *ApiKeyService) Create(apiKey *CreateAPIKey) (*CreateAPIKey, error) {
 
 	return resp.(*CreateAPIKey), nil
 }
+
+// Revoke deletes the API key that belongs to the user by its ID. Requests
+// made with a revoked API key are rejected by the server.
+//
+// Deprecated: use users.RevokeAPIKey
+func (s *ApiKeyService) Revoke(userID string, apiKeyID string) error {
+	if internal.IsEmpty(userID) {
+		return internal.CreateInvalidParameterError("Revoke", "userID")
+	}
+
+	if internal.IsEmpty(apiKeyID) {
+		return internal.CreateInvalidParameterError("Revoke", "apiKeyID")
+	}
+
+	if err := services.ValidateInternalState(s); err != nil {
+		return err
+	}
+
+	path := internal.TrimTemplate(s.GetPath())
+	path = fmt.Sprintf("%s/%s/apikeys/%s", path, userID, apiKeyID)
+
+	return services.ApiDelete(s.GetClient(), path)
+}
+
+// ----- new -----
+
+const apiKeysTemplate = "/api/users/{userId}/apikeys{/id}{?skip,take}"
+
+// AddAPIKey generates a new API key for the user of the input API key. Set
+// Expires to have the server reject the key after a point in time. The API
+// key returned in the result must be saved by the caller, as it cannot be
+// retrieved subsequently from the Octopus server.
+func AddAPIKey(client newclient.Client, apiKey *CreateAPIKey) (*CreateAPIKey, error) {
+	return AddAPIKeyWithContext(context.Background(), client, apiKey)
+}
+
+// AddAPIKeyWithContext is like AddAPIKey, but uses ctx to control cancellation of the HTTP requests.
+func AddAPIKeyWithContext(ctx context.Context, client newclient.Client, apiKey *CreateAPIKey) (*CreateAPIKey, error) {
+	if apiKey == nil {
+		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterAPIKey)
+	}
+	if err := apiKey.Validate(); err != nil {
+		return nil, err
+	}
+
+	path, err := expandAPIKeysPath(client, apiKey.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+	return newclient.PostWithContext[CreateAPIKey](ctx, client.HttpSession(), path, apiKey)
+}
+
+// IterateAPIKeys returns an iterator over the API keys of a user, most recent
+// first. Pages are requested lazily as the iteration proceeds.
+func IterateAPIKeys(client newclient.Client, userID string) iter.Seq2[*APIKey, error] {
+	return IterateAPIKeysWithContext(context.Background(), client, userID)
+}
+
+// IterateAPIKeysWithContext is like IterateAPIKeys, but uses ctx to control cancellation of the HTTP requests.
+func IterateAPIKeysWithContext(ctx context.Context, client newclient.Client, userID string) iter.Seq2[*APIKey, error] {
+	path, err := expandAPIKeysPath(client, userID, "")
+	if err != nil {
+		return func(yield func(*APIKey, error) bool) {
+			yield(nil, err)
+		}
+	}
+	return newclient.IterateByPathWithContext[APIKey](ctx, client, path)
+}
+
+// GetAPIKeyByID returns the API key that belongs to the user by its ID. If
+// one cannot be found, it returns nil and an error.
+func GetAPIKeyByID(client newclient.Client, userID string, apiKeyID string) (*APIKey, error) {
+	return GetAPIKeyByIDWithContext(context.Background(), client, userID, apiKeyID)
+}
+
+// GetAPIKeyByIDWithContext is like GetAPIKeyByID, but uses ctx to control cancellation of the HTTP requests.
+func GetAPIKeyByIDWithContext(ctx context.Context, client newclient.Client, userID string, apiKeyID string) (*APIKey, error) {
+	if internal.IsEmpty(apiKeyID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterAPIKeyID)
+	}
+
+	path, err := expandAPIKeysPath(client, userID, apiKeyID)
+	if err != nil {
+		return nil, err
+	}
+	return newclient.GetWithContext[APIKey](ctx, client.HttpSession(), path)
+}
+
+// RevokeAPIKey deletes the API key that belongs to the user by its ID.
+// Requests made with a revoked API key are rejected by the server.
+func RevokeAPIKey(client newclient.Client, userID string, apiKeyID string) error {
+	return RevokeAPIKeyWithContext(context.Background(), client, userID, apiKeyID)
+}
+
+// RevokeAPIKeyWithContext is like RevokeAPIKey, but uses ctx to control cancellation of the HTTP requests.
+func RevokeAPIKeyWithContext(ctx context.Context, client newclient.Client, userID string, apiKeyID string) error {
+	if internal.IsEmpty(apiKeyID) {
+		return internal.CreateRequiredParameterIsEmptyError(constants.ParameterAPIKeyID)
+	}
+
+	path, err := expandAPIKeysPath(client, userID, apiKeyID)
+	if err != nil {
+		return err
+	}
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), path)
+}
+
+func expandAPIKeysPath(client newclient.Client, userID string, apiKeyID string) (string, error) {
+	if internal.IsEmpty(userID) {
+		return "", internal.CreateRequiredParameterIsEmptyError("userID")
+	}
+
+	values := map[string]any{"userId": userID}
+	if !internal.IsEmpty(apiKeyID) {
+		values["id"] = apiKeyID
+	}
+	return client.URITemplateCache().Expand(apiKeysTemplate, values)
+}
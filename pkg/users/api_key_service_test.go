@@ -47,6 +47,16 @@ func TestAPIKeyServiceGetWithEmptyID(t *testing.T) {
 	assert.Nil(t, resource)
 }
 
+func TestAPIKeyServiceRevokeWithEmptyID(t *testing.T) {
+	service := createAPIKeyService(t)
+
+	err := service.Revoke("", "APIKeys-1")
+	assert.Equal(t, err, internal.CreateInvalidParameterError("Revoke", "userID"))
+
+	err = service.Revoke("Users-1", " ")
+	assert.Equal(t, err, internal.CreateInvalidParameterError("Revoke", "apiKeyID"))
+}
+
 func TestAPIKeyServiceCreate(t *testing.T) {
 	service := createAPIKeyService(t)
 	user := createServiceAccountUser(t)
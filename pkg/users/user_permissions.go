@@ -0,0 +1,146 @@
+package users
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/permissions"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+)
+
+const (
+	permissionsTemplate = "/api/users/{id}/permissions{?spaces,includeSystem}"
+
+	permissionDeploymentCreate = "DeploymentCreate"
+)
+
+// GetPermissions returns the effective permissions of a user, combined from
+// every team the user belongs to. The permissions are limited to the input
+// spaces, or include every space if none are given.
+func GetPermissions(client newclient.Client, userID string, spaceIDs ...string) (*permissions.UserPermissionSet, error) {
+	return GetPermissionsWithContext(context.Background(), client, userID, spaceIDs...)
+}
+
+// GetPermissionsWithContext is like GetPermissions, but uses ctx to control cancellation of the HTTP requests.
+func GetPermissionsWithContext(ctx context.Context, client newclient.Client, userID string, spaceIDs ...string) (*permissions.UserPermissionSet, error) {
+	if internal.IsEmpty(userID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterUserID)
+	}
+
+	values := map[string]any{
+		"id":            userID,
+		"includeSystem": true,
+	}
+	if len(spaceIDs) > 0 {
+		values["spaces"] = spaceIDs
+	}
+	path, err := client.URITemplateCache().Expand(permissionsTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.GetWithContext[permissions.UserPermissionSet](ctx, client.HttpSession(), path)
+}
+
+// HasPermission returns whether a user is granted the named space
+// permission, such as "DeploymentCreate", in a space and scope. The
+// permissions of the user are read from the server and evaluated locally.
+//
+// If the scope has a ProjectID but no ProjectGroupID, the project is read to
+// find its project group, so that restrictions to project groups are
+// checked against it.
+func HasPermission(client newclient.Client, userID string, spaceID string, permission string, scope permissions.PermissionScope) (bool, error) {
+	return HasPermissionWithContext(context.Background(), client, userID, spaceID, permission, scope)
+}
+
+// HasPermissionWithContext is like HasPermission, but uses ctx to control cancellation of the HTTP requests.
+func HasPermissionWithContext(ctx context.Context, client newclient.Client, userID string, spaceID string, permission string, scope permissions.PermissionScope) (bool, error) {
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return false, err
+	}
+
+	if !internal.IsEmpty(scope.ProjectID) && internal.IsEmpty(scope.ProjectGroupID) {
+		project, err := projects.GetByIDWithContext(ctx, client, spaceID, scope.ProjectID)
+		if err != nil {
+			return false, err
+		}
+		scope.ProjectGroupID = project.ProjectGroupID
+	}
+
+	userPermissions, err := GetPermissionsWithContext(ctx, client, userID, spaceID)
+	if err != nil {
+		return false, err
+	}
+	return userPermissions.Can(spaceID, permission, scope)
+}
+
+// EnvironmentDeployer is a user who can deploy to an environment.
+type EnvironmentDeployer struct {
+	User *User
+
+	// Restrictions are the restrictions of the DeploymentCreate permission
+	// which include the environment. They show the projects, project groups
+	// and tenants the user can deploy.
+	Restrictions []permissions.UserPermissionRestriction
+
+	// IsPermissionsComplete is false if the server could not resolve every
+	// team of the user, such as those granted through external security
+	// groups, so the user may be able to deploy more than is reported.
+	IsPermissionsComplete bool
+}
+
+// GetEnvironmentDeployers returns the users who can deploy to an environment
+// in a space, for reviewing access to it. The permissions of every user are
+// read from the server, so this makes one request per user.
+func GetEnvironmentDeployers(client newclient.Client, spaceID string, environmentID string) ([]*EnvironmentDeployer, error) {
+	return GetEnvironmentDeployersWithContext(context.Background(), client, spaceID, environmentID)
+}
+
+// GetEnvironmentDeployersWithContext is like GetEnvironmentDeployers, but uses ctx to control cancellation of the HTTP requests.
+func GetEnvironmentDeployersWithContext(ctx context.Context, client newclient.Client, spaceID string, environmentID string) ([]*EnvironmentDeployer, error) {
+	if internal.IsEmpty(environmentID) {
+		return nil, internal.CreateRequiredParameterIsEmptyError(constants.ParameterEnvironmentID)
+	}
+
+	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
+	if err != nil {
+		return nil, err
+	}
+
+	// users are not scoped to a space, so the collection is walked directly
+	path, err := client.URITemplateCache().Expand(usersTemplate, map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	scope := permissions.PermissionScope{EnvironmentID: environmentID}
+	deployers := []*EnvironmentDeployer{}
+	for user, err := range newclient.IterateByPathWithContext[User](ctx, client, path) {
+		if err != nil {
+			return nil, err
+		}
+		if !user.IsActive {
+			continue
+		}
+
+		userPermissions, err := GetPermissionsWithContext(ctx, client, user.GetID(), spaceID)
+		if err != nil {
+			return nil, err
+		}
+		restrictions, err := userPermissions.RestrictionsFor(spaceID, permissionDeploymentCreate, scope)
+		if err != nil {
+			return nil, err
+		}
+		if len(restrictions) > 0 {
+			deployers = append(deployers, &EnvironmentDeployer{
+				User:                  user,
+				Restrictions:          restrictions,
+				IsPermissionsComplete: userPermissions.IsPermissionsComplete,
+			})
+		}
+	}
+	return deployers, nil
+}
@@ -0,0 +1,106 @@
+package users
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/permissions"
+	testutil "github.com/OctopusDeploy/go-octopusdeploy/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newPermissionsHandler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/users", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"Items": [
+			{"Id": "Users-1", "IsActive": true},
+			{"Id": "Users-2", "IsActive": true},
+			{"Id": "Users-3", "IsActive": true},
+			{"Id": "Users-4", "IsActive": false},
+			{"Id": "Users-5", "IsActive": true}
+		], "Links": {}}`))
+	})
+	mux.HandleFunc("GET /api/users/{id}/permissions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Spaces-1", r.URL.Query().Get("spaces"))
+		require.Equal(t, "true", r.URL.Query().Get("includeSystem"))
+		switch r.PathValue("id") {
+		case "Users-1":
+			// can deploy anything, anywhere in the space
+			_, _ = w.Write([]byte(`{"Id": "Users-1", "IsPermissionsComplete": true, "SystemPermissions": ["AdministerSystem"], "SpacePermissions": {
+				"DeploymentCreate": [{"SpaceId": "Spaces-1", "RestrictedToEnvironmentIds": [], "RestrictedToProjectIds": []}]
+			}}`))
+		case "Users-2":
+			// can deploy one project to production
+			_, _ = w.Write([]byte(`{"Id": "Users-2", "IsPermissionsComplete": false, "SpacePermissions": {
+				"DeploymentCreate": [
+					{"SpaceId": "Spaces-1", "RestrictedToEnvironmentIds": ["Environments-1"]},
+					{"SpaceId": "Spaces-1", "RestrictedToEnvironmentIds": ["Environments-2"], "RestrictedToProjectIds": ["Projects-1"]}
+				]
+			}}`))
+		case "Users-3":
+			// can only view deployments
+			_, _ = w.Write([]byte(`{"Id": "Users-3", "IsPermissionsComplete": true, "SpacePermissions": {
+				"DeploymentView": [{"SpaceId": "Spaces-1"}]
+			}}`))
+		case "Users-5":
+			// can deploy the projects of one project group to test
+			_, _ = w.Write([]byte(`{"Id": "Users-5", "IsPermissionsComplete": true, "SpacePermissions": {
+				"DeploymentCreate": [{"SpaceId": "Spaces-1", "RestrictedToEnvironmentIds": ["Environments-1"], "RestrictedToProjectGroupIds": ["ProjectGroups-2"]}]
+			}}`))
+		default:
+			t.Errorf("the permissions of %s should not be read", r.PathValue("id"))
+		}
+	})
+	mux.HandleFunc("GET /api/Spaces-1/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		projectGroupIDs := map[string]string{"Projects-1": "ProjectGroups-1", "Projects-2": "ProjectGroups-2"}
+		_, _ = fmt.Fprintf(w, `{"Id": %q, "Name": %q, "LifecycleId": "Lifecycles-1", "ProjectGroupId": %q}`, r.PathValue("id"), r.PathValue("id"), projectGroupIDs[r.PathValue("id")])
+	})
+	return mux
+}
+
+func TestHasPermission(t *testing.T) {
+	client := testutil.NewTestClient(t, newPermissionsHandler(t))
+
+	canDeploy := func(userID string, scope permissions.PermissionScope) bool {
+		ok, err := HasPermission(client, userID, "", "DeploymentCreate", scope)
+		require.NoError(t, err)
+		return ok
+	}
+
+	require.True(t, canDeploy("Users-1", permissions.PermissionScope{EnvironmentID: "Environments-2", ProjectID: "Projects-2"}))
+	require.True(t, canDeploy("Users-2", permissions.PermissionScope{EnvironmentID: "Environments-2", ProjectID: "Projects-1"}))
+	require.False(t, canDeploy("Users-2", permissions.PermissionScope{EnvironmentID: "Environments-2", ProjectID: "Projects-2"}))
+	require.True(t, canDeploy("Users-2", permissions.PermissionScope{EnvironmentID: "Environments-2"}))
+	require.False(t, canDeploy("Users-2", permissions.PermissionScope{EnvironmentID: "Environments-3"}))
+	require.False(t, canDeploy("Users-3", permissions.PermissionScope{}))
+
+	// the project group of the project is read to check restrictions to project groups
+	require.False(t, canDeploy("Users-5", permissions.PermissionScope{EnvironmentID: "Environments-1", ProjectID: "Projects-1"}))
+	require.True(t, canDeploy("Users-5", permissions.PermissionScope{EnvironmentID: "Environments-1", ProjectID: "Projects-2"}))
+	require.False(t, canDeploy("Users-5", permissions.PermissionScope{EnvironmentID: "Environments-1", ProjectGroupID: "ProjectGroups-1"}))
+
+	_, err := HasPermission(client, "Users-1", "", "DeployAnything", permissions.PermissionScope{})
+	require.Error(t, err)
+
+	userPermissions, err := GetPermissions(client, "Users-1", "Spaces-1")
+	require.NoError(t, err)
+	require.True(t, userPermissions.HasSystemPermission("AdministerSystem"))
+}
+
+func TestGetEnvironmentDeployers(t *testing.T) {
+	client := testutil.NewTestClient(t, newPermissionsHandler(t))
+
+	deployers, err := GetEnvironmentDeployers(client, "", "Environments-2")
+	require.NoError(t, err)
+	require.Len(t, deployers, 2)
+	require.Equal(t, "Users-1", deployers[0].User.GetID())
+	require.True(t, deployers[0].IsPermissionsComplete)
+	require.Equal(t, "Users-2", deployers[1].User.GetID())
+	require.False(t, deployers[1].IsPermissionsComplete)
+	require.Len(t, deployers[1].Restrictions, 1)
+	require.Equal(t, []string{"Projects-1"}, deployers[1].Restrictions[0].RestrictedToProjectIds)
+
+	_, err = GetEnvironmentDeployers(client, "", "")
+	require.Error(t, err)
+}
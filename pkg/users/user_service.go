@@ -1,6 +1,9 @@
 package users
 
 import (
+	"context"
+	"iter"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/constants"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
@@ -227,6 +230,9 @@ func (s *UserService) GetMe() (*User, error) {
 	return resp.(*User), nil
 }
 
+// GetPermissions returns the permissions of the input user.
+//
+// Deprecated: use users.GetPermissions
 func (s *UserService) GetPermissions(user *User, userQuery ...UserQuery) (*permissions.UserPermissionSet, error) {
 	if user == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterUser)
@@ -317,6 +323,11 @@ func (s *UserService) Update(user *User) (*User, error) {
 
 // Add creates a new user.
 func Add(client newclient.Client, user *User) (*User, error) {
+	return AddWithContext(context.Background(), client, user)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, user *User) (*User, error) {
 	if IsNil(user) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationAdd, constants.ParameterUser)
 	}
@@ -328,7 +339,7 @@ func Add(client newclient.Client, user *User) (*User, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Post[User](client.HttpSession(), expandedUri, user)
+	resp, err := newclient.PostWithContext[User](ctx, client.HttpSession(), expandedUri, user)
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +351,11 @@ func Add(client newclient.Client, user *User) (*User, error) {
 // query parameter. If an error occurs, an empty collection is returned along
 // with the associated error.
 func Get(client newclient.Client, spaceID string, usersQuery UsersQuery) (*resources.Resources[*User], error) {
+	return GetWithContext(context.Background(), client, spaceID, usersQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, usersQuery UsersQuery) (*resources.Resources[*User], error) {
 	spaceID, err := internal.GetSpaceID(spaceID, client.GetSpaceID())
 	if err != nil {
 		return nil, err
@@ -356,7 +372,7 @@ func Get(client newclient.Client, spaceID string, usersQuery UsersQuery) (*resou
 		return nil, err
 	}
 
-	resp, err := newclient.Get[resources.Resources[*User]](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[resources.Resources[*User]](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return &resources.Resources[*User]{}, err
 	}
@@ -364,9 +380,26 @@ func Get(client newclient.Client, spaceID string, usersQuery UsersQuery) (*resou
 	return resp, nil
 }
 
+// Iterate returns an iterator over the users matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query UsersQuery) iter.Seq2[*User, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query UsersQuery) iter.Seq2[*User, error] {
+	return newclient.IterateByQueryWithContext[User](ctx, client, usersTemplate, spaceID, query)
+}
+
 // GetByID returns the user that matches the input ID. If one cannot be found,
 // it returns nil and an error.
 func GetByID(client newclient.Client, id string) (*User, error) {
+	return GetByIDWithContext(context.Background(), client, id)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, id string) (*User, error) {
 	if internal.IsEmpty(id) {
 		return nil, internal.CreateInvalidParameterError(constants.OperationGetByID, constants.ParameterID)
 	}
@@ -378,7 +411,7 @@ func GetByID(client newclient.Client, id string) (*User, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Get[User](client.HttpSession(), expandedUri)
+	resp, err := newclient.GetWithContext[User](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +421,11 @@ func GetByID(client newclient.Client, id string) (*User, error) {
 
 // Update modifies a user based on the one provided as input.
 func Update(client newclient.Client, user *User) (*User, error) {
+	return UpdateWithContext(context.Background(), client, user)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, user *User) (*User, error) {
 	if user == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterUser)
 	}
@@ -399,7 +437,7 @@ func Update(client newclient.Client, user *User) (*User, error) {
 		return nil, err
 	}
 
-	resp, err := newclient.Put[User](client.HttpSession(), expandedUri, user)
+	resp, err := newclient.PutWithContext[User](ctx, client.HttpSession(), expandedUri, user)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +447,11 @@ func Update(client newclient.Client, user *User) (*User, error) {
 
 // DeleteByID deletes the resource that matches the space ID and input ID.
 func DeleteByID(client newclient.Client, id string) error {
+	return DeleteByIDWithContext(context.Background(), client, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, id string) error {
 	if internal.IsEmpty(id) {
 		return internal.CreateInvalidParameterError(constants.OperationDeleteByID, constants.ParameterID)
 	}
@@ -420,5 +463,5 @@ func DeleteByID(client newclient.Client, id string) error {
 		return err
 	}
 
-	return newclient.Delete(client.HttpSession(), expandedUri)
+	return newclient.DeleteWithContext(ctx, client.HttpSession(), expandedUri)
 }
@@ -1,6 +1,7 @@
 package variables
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -337,6 +338,11 @@ var _ services.IService = &VariableService{}
 // This might be a project level variable set (ID might be 'variableset-Projects-314')
 // or it might be a release snapshot variable set (ID might be 'variableset-Projects-314-s-2-XM74V')
 func GetVariableSet(client newclient.Client, spaceID string, ID string) (*VariableSet, error) {
+	return GetVariableSetWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetVariableSetWithContext is like GetVariableSet, but uses ctx to control cancellation of the HTTP requests.
+func GetVariableSetWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (*VariableSet, error) {
 	if client == nil {
 		return nil, internal.CreateInvalidParameterError("GetVariableSet", "client")
 	}
@@ -354,11 +360,16 @@ func GetVariableSet(client newclient.Client, spaceID string, ID string) (*Variab
 	if err != nil {
 		return nil, err
 	}
-	return newclient.Get[VariableSet](client.HttpSession(), expandedUri)
+	return newclient.GetWithContext[VariableSet](ctx, client.HttpSession(), expandedUri)
 }
 
 // GetByID fetches a single variable, located by its ID, from Octopus Deploy for a given space ID and owner ID.
 func GetByID(client newclient.Client, spaceID string, ownerID string, variableID string) (*Variable, error) {
+	return GetByIDWithContext(context.Background(), client, spaceID, ownerID, variableID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, variableID string) (*Variable, error) {
 	if internal.IsEmpty(ownerID) {
 		return nil, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -372,7 +383,7 @@ func GetByID(client newclient.Client, spaceID string, ownerID string, variableID
 		return nil, err
 	}
 
-	variables, err := GetAll(client, spaceID, ownerID)
+	variables, err := GetAllWithContext(ctx, client, spaceID, ownerID)
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +404,11 @@ func GetByID(client newclient.Client, spaceID string, ownerID string, variableID
 // names can appear more than once under different scopes, a VariableScope must also be provided, which will
 // be used to locate the appropriate variables.
 func GetByName(client newclient.Client, spaceID string, ownerID string, name string, scope *VariableScope) ([]*Variable, error) {
+	return GetByNameWithContext(context.Background(), client, spaceID, ownerID, name, scope)
+}
+
+// GetByNameWithContext is like GetByName, but uses ctx to control cancellation of the HTTP requests.
+func GetByNameWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, name string, scope *VariableScope) ([]*Variable, error) {
 	if internal.IsEmpty(ownerID) {
 		return nil, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -406,7 +422,7 @@ func GetByName(client newclient.Client, spaceID string, ownerID string, name str
 		return nil, err
 	}
 
-	variables, err := GetAll(client, spaceID, ownerID)
+	variables, err := GetAllWithContext(ctx, client, spaceID, ownerID)
 	if err != nil {
 		return nil, err
 	}
@@ -430,6 +446,11 @@ func GetByName(client newclient.Client, spaceID string, ownerID string, name str
 
 // GetAll fetches a collection of variables for an owner ID.
 func GetAll(client newclient.Client, spaceID string, ownerID string) (VariableSet, error) {
+	return GetAllWithContext(context.Background(), client, spaceID, ownerID)
+}
+
+// GetAllWithContext is like GetAll, but uses ctx to control cancellation of the HTTP requests.
+func GetAllWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string) (VariableSet, error) {
 	if internal.IsEmpty(ownerID) {
 		return VariableSet{}, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -449,7 +470,7 @@ func GetAll(client newclient.Client, spaceID string, ownerID string) (VariableSe
 		return VariableSet{}, err
 	}
 
-	response, err := newclient.Get[VariableSet](client.HttpSession(), expandedUri)
+	response, err := newclient.GetWithContext[VariableSet](ctx, client.HttpSession(), expandedUri)
 	if err != nil {
 		return VariableSet{}, err
 	}
@@ -460,6 +481,11 @@ func GetAll(client newclient.Client, spaceID string, ownerID string) (VariableSe
 // AddSingle adds a single variable to an owner ID. This automates the act of fetching
 // the variable set, adding a new item to it, and posting back to Octopus
 func AddSingle(client newclient.Client, spaceID string, ownerID string, variable *Variable) (VariableSet, error) {
+	return AddSingleWithContext(context.Background(), client, spaceID, ownerID, variable)
+}
+
+// AddSingleWithContext is like AddSingle, but uses ctx to control cancellation of the HTTP requests.
+func AddSingleWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, variable *Variable) (VariableSet, error) {
 	if internal.IsEmpty(ownerID) {
 		return VariableSet{}, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -469,23 +495,28 @@ func AddSingle(client newclient.Client, spaceID string, ownerID string, variable
 		return VariableSet{}, err
 	}
 
-	variables, err := GetAll(client, spaceID, ownerID)
+	variables, err := GetAllWithContext(ctx, client, spaceID, ownerID)
 	if err != nil {
 		return VariableSet{}, err
 	}
 
 	variables.Variables = append(variables.Variables, variable)
-	return Update(client, spaceID, ownerID, variables)
+	return UpdateWithContext(ctx, client, spaceID, ownerID, variables)
 }
 
 // UpdateSingle adds a single variable to an owner ID. This automates the act of fetching
 // the variable set, updating the existing item, and posting back to Octopus
 func UpdateSingle(client newclient.Client, spaceID string, ownerID string, variable *Variable) (VariableSet, error) {
+	return UpdateSingleWithContext(context.Background(), client, spaceID, ownerID, variable)
+}
+
+// UpdateSingleWithContext is like UpdateSingle, but uses ctx to control cancellation of the HTTP requests.
+func UpdateSingleWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, variable *Variable) (VariableSet, error) {
 	if internal.IsEmpty(ownerID) {
 		return VariableSet{}, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
 
-	variables, err := GetAll(client, spaceID, ownerID)
+	variables, err := GetAllWithContext(ctx, client, spaceID, ownerID)
 	if err != nil {
 		return VariableSet{}, err
 	}
@@ -493,7 +524,7 @@ func UpdateSingle(client newclient.Client, spaceID string, ownerID string, varia
 	for k, v := range variables.Variables {
 		if v.GetID() == variable.ID {
 			variables.Variables[k] = variable
-			return Update(client, spaceID, ownerID, variables)
+			return UpdateWithContext(ctx, client, spaceID, ownerID, variables)
 		}
 	}
 
@@ -503,6 +534,11 @@ func UpdateSingle(client newclient.Client, spaceID string, ownerID string, varia
 // Update takes an entire variable set and posts the entire set back to Octopus Deploy. There are individual
 // functions like AddSingle and UpdateSingle that can make this process more of a "typical" CRUD Octopus command.
 func Update(client newclient.Client, spaceID string, ownerID string, variableSet VariableSet) (VariableSet, error) {
+	return UpdateWithContext(context.Background(), client, spaceID, ownerID, variableSet)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, variableSet VariableSet) (VariableSet, error) {
 	if internal.IsEmpty(ownerID) {
 		return VariableSet{}, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -522,7 +558,7 @@ func Update(client newclient.Client, spaceID string, ownerID string, variableSet
 		return VariableSet{}, err
 	}
 
-	if _, err := newclient.Put[VariableSet](client.HttpSession(), expandedUri, variableSet); err != nil {
+	if _, err := newclient.PutWithContext[VariableSet](ctx, client.HttpSession(), expandedUri, variableSet); err != nil {
 		return VariableSet{}, err
 	}
 
@@ -530,7 +566,7 @@ func Update(client newclient.Client, spaceID string, ownerID string, variableSet
 	// via HTTP GET (below) due to a bug for HTTP POST and HTTP PUT which will
 	// provide a null scope value set in their responses
 
-	return GetAll(client, spaceID, ownerID)
+	return GetAllWithContext(ctx, client, spaceID, ownerID)
 }
 
 // MatchesScope compares two different scopes to see if they match. Generally used for comparing the scope of
@@ -618,6 +654,11 @@ func MatchesScope(variableScope VariableScope, definedScope *VariableScope) (boo
 // DeleteSingle removes a single variable from an owner ID. This automates the act of fetching
 // the variable set, removing the existing item, and posting back to Octopus
 func DeleteSingle(client newclient.Client, spaceID string, ownerID string, variableID string) (VariableSet, error) {
+	return DeleteSingleWithContext(context.Background(), client, spaceID, ownerID, variableID)
+}
+
+// DeleteSingleWithContext is like DeleteSingle, but uses ctx to control cancellation of the HTTP requests.
+func DeleteSingleWithContext(ctx context.Context, client newclient.Client, spaceID string, ownerID string, variableID string) (VariableSet, error) {
 	if internal.IsEmpty(ownerID) {
 		return VariableSet{}, errInvalidVariableServiceParameter{ParameterName: "ownerID"}
 	}
@@ -631,7 +672,7 @@ func DeleteSingle(client newclient.Client, spaceID string, ownerID string, varia
 		return VariableSet{}, err
 	}
 
-	variableSet, err := GetAll(client, spaceID, ownerID)
+	variableSet, err := GetAllWithContext(ctx, client, spaceID, ownerID)
 	if err != nil {
 		return VariableSet{}, err
 	}
@@ -651,5 +692,5 @@ func DeleteSingle(client newclient.Client, spaceID string, ownerID string, varia
 		}
 	}
 
-	return Update(client, spaceID, ownerID, variableSet)
+	return UpdateWithContext(ctx, client, spaceID, ownerID, variableSet)
 }
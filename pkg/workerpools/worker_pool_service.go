@@ -1,7 +1,9 @@
 package workerpools
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"strings"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/internal"
@@ -230,7 +232,12 @@ const (
 
 // Add creates a new worker pool.
 func Add(client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error) {
-	res, err := newclient.Add[WorkerPoolResource](client, template, workerPool.GetSpaceID(), workerPool)
+	return AddWithContext(context.Background(), client, workerPool)
+}
+
+// AddWithContext is like Add, but uses ctx to control cancellation of the HTTP requests.
+func AddWithContext(ctx context.Context, client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error) {
+	res, err := newclient.AddWithContext[WorkerPoolResource](ctx, client, template, workerPool.GetSpaceID(), workerPool)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +247,12 @@ func Add(client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error) {
 // Get returns a collection of worker pools based on the criteria defined by
 // its input query parameter.
 func Get(client newclient.Client, spaceID string, workerPoolsQuery WorkerPoolsQuery) (*WorkerPools, error) {
-	res, err := newclient.GetByQuery[WorkerPoolResource](client, template, spaceID, workerPoolsQuery)
+	return GetWithContext(context.Background(), client, spaceID, workerPoolsQuery)
+}
+
+// GetWithContext is like Get, but uses ctx to control cancellation of the HTTP requests.
+func GetWithContext(ctx context.Context, client newclient.Client, spaceID string, workerPoolsQuery WorkerPoolsQuery) (*WorkerPools, error) {
+	res, err := newclient.GetByQueryWithContext[WorkerPoolResource](ctx, client, template, spaceID, workerPoolsQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -248,10 +260,38 @@ func Get(client newclient.Client, spaceID string, workerPoolsQuery WorkerPoolsQu
 	return ToWorkerPools(res), nil
 }
 
+// Iterate returns an iterator over the worker pools matching the criteria defined by
+// its input query parameter. Pages are requested lazily as the iteration
+// proceeds; the query's Take controls the page size.
+func Iterate(client newclient.Client, spaceID string, query WorkerPoolsQuery) iter.Seq2[IWorkerPool, error] {
+	return IterateWithContext(context.Background(), client, spaceID, query)
+}
+
+// IterateWithContext is like Iterate, but uses ctx to control cancellation of the HTTP requests.
+func IterateWithContext(ctx context.Context, client newclient.Client, spaceID string, query WorkerPoolsQuery) iter.Seq2[IWorkerPool, error] {
+	return func(yield func(IWorkerPool, error) bool) {
+		for res, err := range newclient.IterateByQueryWithContext[WorkerPoolResource](ctx, client, template, spaceID, query) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			workerPool, err := ToWorkerPool(res)
+			if !yield(workerPool, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
 // GetByID returns the worker pool that matches the input ID. If one cannot be
 // found, it returns nil and an error.
 func GetByID(client newclient.Client, spaceID string, ID string) (IWorkerPool, error) {
-	res, err := newclient.GetByID[WorkerPoolResource](client, template, spaceID, ID)
+	return GetByIDWithContext(context.Background(), client, spaceID, ID)
+}
+
+// GetByIDWithContext is like GetByID, but uses ctx to control cancellation of the HTTP requests.
+func GetByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, ID string) (IWorkerPool, error) {
+	res, err := newclient.GetByIDWithContext[WorkerPoolResource](ctx, client, template, spaceID, ID)
 	if err != nil {
 		return nil, err
 	}
@@ -261,11 +301,21 @@ func GetByID(client newclient.Client, spaceID string, ID string) (IWorkerPool, e
 
 // DeleteByID will delete a workerpool with the provided id.
 func DeleteByID(client newclient.Client, spaceID string, id string) error {
-	return newclient.DeleteByID(client, template, spaceID, id)
+	return DeleteByIDWithContext(context.Background(), client, spaceID, id)
+}
+
+// DeleteByIDWithContext is like DeleteByID, but uses ctx to control cancellation of the HTTP requests.
+func DeleteByIDWithContext(ctx context.Context, client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByIDWithContext(ctx, client, template, spaceID, id)
 }
 
 // Update modifies a worker pool based on the one provided as input.
 func Update(client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error) {
+	return UpdateWithContext(context.Background(), client, workerPool)
+}
+
+// UpdateWithContext is like Update, but uses ctx to control cancellation of the HTTP requests.
+func UpdateWithContext(ctx context.Context, client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error) {
 	if workerPool == nil {
 		return nil, internal.CreateRequiredParameterIsEmptyOrNilError(constants.ParameterWorkerPool)
 	}
@@ -273,7 +323,7 @@ func Update(client newclient.Client, workerPool IWorkerPool) (IWorkerPool, error
 	if err != nil {
 		return nil, err
 	}
-	res, err := newclient.Update[WorkerPoolResource](client, template, workerPoolResource.SpaceID, workerPoolResource.ID, workerPoolResource)
+	res, err := newclient.UpdateWithContext[WorkerPoolResource](ctx, client, template, workerPoolResource.SpaceID, workerPoolResource.ID, workerPoolResource)
 	if err != nil {
 		return nil, err
 	}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
 	"github.com/dghubble/sling"
 	"github.com/stretchr/testify/assert"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -161,3 +164,18 @@ func (r *RequestWrapper) RespondWith(responseObject any) {
 		ContentLength: int64(len(body)),
 	}, nil)
 }
+
+// NewTestClient starts an HTTP server which serves requests with handler, and
+// returns a client for Spaces-1 which talks to it. The server is closed when
+// the test finishes.
+func NewTestClient(t *testing.T, handler http.Handler) newclient.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return newclient.NewClientS(&newclient.HttpSession{HttpClient: server.Client(), BaseURL: baseURL}, "Spaces-1")
+}
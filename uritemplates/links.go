@@ -7,6 +7,7 @@ package uritemplates
 const (
 	BuildInformation                    = "/api/{spaceId}/build-information{/id}{?packageId,filter,latest,skip,take,overwriteMode}"
 	BuildInformationBulk                = "/api/{spaceId}/build-information/bulk{?ids}"
+	ChannelVersionRuleTest              = "/api/{spaceId}/channels/rule-test{?version,versionRange,preReleaseTag,feedType}"                                                               // GET
 	CreateReleaseCommandV1              = "/api/{spaceId}/releases/create/v1"                                                                                                             // POST
 	CreateDeploymentTenantedCommandV1   = "/api/{spaceId}/deployments/create/tenanted/v1"                                                                                                 // POST
 	CreateDeploymentUntenantedCommandV1 = "/api/{spaceId}/deployments/create/untenanted/v1"                                                                                               // POST
@@ -14,10 +15,16 @@ const (
 	DeploymentProcesses                 = "/api/{spaceId}/deploymentprocesses{/id}{?skip,take,ids}"                                                                                       // GET
 	FeedSearchPackageVersions           = "/api/{spaceId}/feeds/{feedId}/packages/versions{?packageId,take,skip,includePreRelease,versionRange,preReleaseTag,filter,includeReleaseNotes}" // GET
 	Packages                            = "/api/{spaceId}/packages{/id}{?nuGetPackageId,filter,latest,skip,take,includeNotes}"                                                            // GET
+	MigrationsImport                    = "/api/migrations/import"                                                                                                                        // POST
+	MigrationsPartialExport             = "/api/migrations/partialexport"                                                                                                                 // POST
 	LibraryVariableSets                 = "/api/{spaceId}/libraryvariablesets{/id}{?skip,contentType,take,ids,partialName}"
+	PackageDeltaSignature               = "/api/{spaceId}/packages/{packageId}/{version}/delta-signature"                                                     // GET
+	PackageDeltaUpload                  = "/api/{spaceId}/packages/{packageId}/{baseVersion}/delta{?replace,overwriteMode}"                                   // POST multipart form
+	PackageRaw                          = "/api/{spaceId}/packages/{id}/raw"                                                                                  // GET
 	PackageUpload                       = "/api/{spaceId}/packages/raw{?replace,overwriteMode}"                                                               // POST multipart form
 	ReleaseDeploymentPreview            = "/api/{spaceId}/releases/{releaseId}/deployments/preview/{environmentId}{?includeDisabledSteps}"                    // GET
 	ReleaseDeploymentPreviews           = "/api/{spaceId}/releases/{releaseId}/deployments/previews"                                                          // POST multipart form
+	ReleaseProgression                  = "/api/{spaceId}/releases/{id}/progression"                                                                          // GET
 	Releases                            = "/api/{spaceId}/releases{/id}{?skip,ignoreChannelRules,take,ids}"                                                   // GET
 	ReleasesByProject                   = "/api/{spaceId}/projects/{projectId}/releases{/version}{?skip,take,searchByVersion}"                                // GET
 	ReleasesByProjectAndChannel         = "/api/{spaceId}/projects/{projectId}/channels/{channelId}/releases{?skip,take,searchByVersion}"                     // GET